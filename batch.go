@@ -0,0 +1,302 @@
+package shopping
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ItemFailureReason describes why an item was not retrieved by a batch call
+type ItemFailureReason string
+
+const (
+	// ItemFailureInvalid means eBay reported the ItemID as invalid or nonexistent
+	ItemFailureInvalid ItemFailureReason = "Invalid"
+	// ItemFailureMissing means eBay silently omitted the item from the response
+	ItemFailureMissing ItemFailureReason = "Missing"
+	// ItemFailureEnded means the listing has ended
+	ItemFailureEnded ItemFailureReason = "Ended"
+)
+
+// ItemFailure describes an item which was not retrieved by a batch call
+type ItemFailure struct {
+	ItemID string
+	Reason ItemFailureReason
+	// Errors are eBay errors related to the ItemID (if any)
+	Errors []Error
+	// Item is set for ended listings
+	Item *Item
+}
+
+// ChunkError describes a chunk of a batch call which failed as a whole (e.g. due to network error)
+type ChunkError struct {
+	ItemIDs []string
+	Err     error
+}
+
+// BatchError is returned by batch calls when some chunks failed.
+// Results of successful chunks are returned together with BatchError.
+type BatchError struct {
+	Operation EbayOperation
+	Chunks    []ChunkError
+}
+
+// Error implements error interface
+func (e *BatchError) Error() string {
+	msgs := make([]string, 0, len(e.Chunks))
+	for _, c := range e.Chunks {
+		msgs = append(msgs, c.Err.Error())
+	}
+	return fmt.Sprintf("%s: %d chunk(s) failed: %s", e.Operation, len(e.Chunks), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the error of the first failed chunk
+func (e *BatchError) Unwrap() error {
+	if len(e.Chunks) == 0 {
+		return nil
+	}
+	return e.Chunks[0].Err
+}
+
+// GetItemsResult is a result of Service.GetItems
+type GetItemsResult struct {
+	// Items are retrieved items in the order of requested IDs
+	Items []Item
+	// Failures are items which were not retrieved, in the order of requested IDs
+	Failures []ItemFailure
+}
+
+// GetItems retrieves items with any number of IDs using GetMultipleItems calls.
+// IDs are de-duplicated and split into chunks of MaxItemIDsPerCall IDs.
+// Chunks are executed concurrently (see Service.WithBatchConcurrency).
+//
+// Items which eBay reported as invalid, omitted from the response or which have ended
+// are returned in GetItemsResult.Failures. If some chunks failed as a whole,
+// results of other chunks are returned together with *BatchError.
+func (s *Service) GetItems(ctx context.Context, itemIDs []string, selectors ...IncludeSelectorGMIOption) (GetItemsResult, error) {
+	ids := uniqueStrings(itemIDs)
+	chunks := chunkStrings(ids, MaxItemIDsPerCall)
+	responses := make([]GetMultipleItemsResponse, len(chunks))
+	errs := s.runChunks(ctx, len(chunks), func(ctx context.Context, i int) error {
+		req := s.NewGetMultipleItemsRequest().WithItemIDs(chunks[i]...)
+		if len(selectors) > 0 {
+			req.WithIncludeSelector(selectors...)
+		}
+		res, err := req.ExecuteContext(ctx)
+		if err != nil && !isAPIFailure(err) {
+			return err
+		}
+		if err != nil && len(invalidItemErrors(res.Errors, chunks[i])) == 0 {
+			// the call failed, but eBay did not point to particular items
+			return err
+		}
+		responses[i] = res
+		return nil
+	})
+
+	items := make(map[string]Item)
+	var invalid map[string][]Error
+	for i, res := range responses {
+		if errs[i] != nil {
+			continue
+		}
+		for _, item := range res.Items {
+			items[item.ItemID] = item
+		}
+		invalid = mergeErrors(invalid, invalidItemErrors(res.Errors, chunks[i]))
+	}
+
+	result := GetItemsResult{}
+	for i, chunk := range chunks {
+		if errs[i] != nil {
+			continue
+		}
+		for _, id := range chunk {
+			item, ok := items[id]
+			switch {
+			case ok && isEndedListing(item.ListingStatus):
+				item := item
+				result.Failures = append(result.Failures, ItemFailure{ItemID: id, Reason: ItemFailureEnded, Item: &item})
+			case ok:
+				result.Items = append(result.Items, item)
+			case len(invalid[id]) > 0:
+				result.Failures = append(result.Failures, ItemFailure{ItemID: id, Reason: ItemFailureInvalid, Errors: invalid[id]})
+			default:
+				result.Failures = append(result.Failures, ItemFailure{ItemID: id, Reason: ItemFailureMissing})
+			}
+		}
+	}
+	return result, newBatchError(OperationGetMultipleItems, chunks, errs)
+}
+
+// GetItemStatusesResult is a result of Service.GetItemStatuses
+type GetItemStatusesResult struct {
+	// ItemIDs are de-duplicated requested IDs in the order of the request
+	ItemIDs []string
+	// Items are retrieved statuses keyed by ItemID
+	Items map[string]StatusItem
+	// Missing are IDs which eBay omitted from the response (or reported as invalid), in the order of the request
+	Missing []string
+}
+
+// GetItemStatuses retrieves statuses of listings with any number of IDs using GetItemStatus calls.
+// IDs are de-duplicated and split into chunks of MaxItemIDsPerCall IDs.
+// Chunks are executed concurrently (see Service.WithBatchConcurrency).
+//
+// If some chunks failed as a whole, results of other chunks are returned together with *BatchError.
+// IDs of failed chunks are not included in GetItemStatusesResult.Missing.
+func (s *Service) GetItemStatuses(ctx context.Context, itemIDs []string) (GetItemStatusesResult, error) {
+	ids := uniqueStrings(itemIDs)
+	chunks := chunkStrings(ids, MaxItemIDsPerCall)
+	responses := make([]GetItemStatusResponse, len(chunks))
+	errs := s.runChunks(ctx, len(chunks), func(ctx context.Context, i int) error {
+		res, err := s.NewGetItemStatusRequest().WithItemIDs(chunks[i]...).ExecuteContext(ctx)
+		if err != nil && !isAPIFailure(err) {
+			return err
+		}
+		if err != nil && len(invalidItemErrors(res.Errors, chunks[i])) == 0 {
+			// the call failed, but eBay did not point to particular items
+			return err
+		}
+		responses[i] = res
+		return nil
+	})
+
+	result := GetItemStatusesResult{
+		ItemIDs: ids,
+		Items:   make(map[string]StatusItem, len(ids)),
+	}
+	for i, res := range responses {
+		if errs[i] != nil {
+			continue
+		}
+		for _, item := range res.Items {
+			result.Items[item.ItemID] = item
+		}
+	}
+	for i, chunk := range chunks {
+		if errs[i] != nil {
+			continue
+		}
+		for _, id := range chunk {
+			if _, ok := result.Items[id]; !ok {
+				result.Missing = append(result.Missing, id)
+			}
+		}
+	}
+	return result, newBatchError(OperationGetItemStatus, chunks, errs)
+}
+
+// isEndedListing checks if ListingStatus means that the listing has ended
+func isEndedListing(status string) bool {
+	return status == "Completed" || status == "Ended"
+}
+
+// invalidItemErrors returns eBay errors which point to particular item IDs
+func invalidItemErrors(errs []Error, ids []string) map[string][]Error {
+	known := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		known[id] = struct{}{}
+	}
+	invalid := make(map[string][]Error)
+	for _, er := range errs {
+		if er.ErrorCode != ErrorCodeInvalidItemID {
+			continue
+		}
+		for _, p := range er.ErrorParameters {
+			for _, id := range strings.Split(p.Value, ",") {
+				id = strings.TrimSpace(id)
+				if _, ok := known[id]; ok {
+					invalid[id] = append(invalid[id], er)
+				}
+			}
+		}
+	}
+	return invalid
+}
+
+// mergeErrors adds errors from src to dst
+func mergeErrors(dst, src map[string][]Error) map[string][]Error {
+	if dst == nil {
+		dst = make(map[string][]Error)
+	}
+	for id, errs := range src {
+		dst[id] = append(dst[id], errs...)
+	}
+	return dst
+}
+
+// newBatchError creates *BatchError from errors of chunks. It returns nil if all chunks succeeded.
+func newBatchError(operation EbayOperation, chunks [][]string, errs []error) error {
+	var failed []ChunkError
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, ChunkError{ItemIDs: chunks[i], Err: err})
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &BatchError{Operation: operation, Chunks: failed}
+}
+
+// runChunks calls fn for chunks 0..n-1 with bounded concurrency and returns their errors
+func (s *Service) runChunks(ctx context.Context, n int, fn func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+	concurrency := s.batchConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+loop:
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			for j := i; j < n; j++ {
+				errs[j] = ctx.Err()
+			}
+			break loop
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+// uniqueStrings removes empty and duplicate strings keeping the order of first occurrences
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		unique = append(unique, v)
+	}
+	return unique
+}
+
+// chunkStrings splits values into chunks of size elements
+func chunkStrings(values []string, size int) [][]string {
+	var chunks [][]string
+	for len(values) > size {
+		chunks = append(chunks, values[:size:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
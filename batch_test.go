@@ -0,0 +1,176 @@
+package shopping
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newMultipleItemsServer starts test server which emulates GetMultipleItems call.
+// IDs starting with "bad" are invalid, "gone" are omitted, "end" are ended listings
+// and a chunk with "boom" fails with status 500.
+func newMultipleItemsServer(t *testing.T, calls, maxActive *int64) *httptest.Server {
+	var active int64
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(calls, 1)
+		n := atomic.AddInt64(&active, 1)
+		defer atomic.AddInt64(&active, -1)
+		for {
+			m := atomic.LoadInt64(maxActive)
+			if n <= m || atomic.CompareAndSwapInt64(maxActive, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		b, _ := ioutil.ReadAll(r.Body)
+		req := GetMultipleItemsRequest{}
+		if !assert.NoError(t, xml.Unmarshal(b, &req)) {
+			return
+		}
+		assert.True(t, len(req.ItemIDs) <= MaxItemIDsPerCall)
+		assert.Equal(t, "Details", req.IncludeSelector)
+
+		sb := strings.Builder{}
+		ack := AckSuccess
+		for _, id := range req.ItemIDs {
+			switch {
+			case strings.HasPrefix(id, "boom"):
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			case strings.HasPrefix(id, "bad"):
+				ack = AckPartialFailure
+				sb.WriteString(fmt.Sprintf(`<Errors><ErrorCode>10.12</ErrorCode><ShortMessage>Invalid item ID.</ShortMessage>`+
+					`<ErrorParameters ParamID="0"><Value>%s</Value></ErrorParameters></Errors>`, id))
+			case strings.HasPrefix(id, "gone"):
+			case strings.HasPrefix(id, "end"):
+				sb.WriteString(fmt.Sprintf(`<Item><ItemID>%s</ItemID><ListingStatus>Completed</ListingStatus></Item>`, id))
+			default:
+				sb.WriteString(fmt.Sprintf(`<Item><ItemID>%s</ItemID><ListingStatus>Active</ListingStatus></Item>`, id))
+			}
+		}
+		_, _ = fmt.Fprintf(w, `<GetMultipleItemsResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>%s</Ack>%s</GetMultipleItemsResponse>`,
+			ack, sb.String())
+	}))
+}
+
+func TestService_GetItems(t *testing.T) {
+	var calls, maxActive int64
+	server := newMultipleItemsServer(t, &calls, &maxActive)
+	defer server.Close()
+	service := NewService("").WithEndpoint(server.URL).WithBatchConcurrency(2)
+
+	var ids []string
+	for i := 0; i < 50; i++ {
+		ids = append(ids, fmt.Sprintf("%d", i))
+	}
+	ids = append(ids, "bad1", "gone1", "end1", "7", "", "3")
+
+	res, err := service.GetItems(context.Background(), ids, IncludeSelectorMIDetails)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, int64(3), atomic.LoadInt64(&calls))
+	assert.True(t, atomic.LoadInt64(&maxActive) <= 2)
+	if assert.Len(t, res.Items, 50) {
+		for i, item := range res.Items {
+			assert.Equal(t, fmt.Sprintf("%d", i), item.ItemID)
+		}
+	}
+	if assert.Len(t, res.Failures, 3) {
+		assert.Equal(t, ItemFailure{ItemID: "bad1", Reason: ItemFailureInvalid, Errors: res.Failures[0].Errors}, res.Failures[0])
+		assert.Len(t, res.Failures[0].Errors, 1)
+		assert.Equal(t, ItemFailure{ItemID: "gone1", Reason: ItemFailureMissing}, res.Failures[1])
+		assert.Equal(t, ItemFailureEnded, res.Failures[2].Reason)
+		assert.Equal(t, "end1", res.Failures[2].Item.ItemID)
+	}
+}
+
+func TestService_GetItemsChunkFailure(t *testing.T) {
+	var calls, maxActive int64
+	server := newMultipleItemsServer(t, &calls, &maxActive)
+	defer server.Close()
+	service := NewService("").WithEndpoint(server.URL)
+
+	var ids []string
+	for i := 0; i < 25; i++ {
+		ids = append(ids, fmt.Sprintf("%d", i))
+	}
+	ids = append(ids, "boom")
+
+	res, err := service.GetItems(context.Background(), ids, IncludeSelectorMIDetails)
+	var batchErr *BatchError
+	if !assert.True(t, errors.As(err, &batchErr)) {
+		return
+	}
+	if assert.Len(t, batchErr.Chunks, 1) {
+		assert.Equal(t, ids[20:], batchErr.Chunks[0].ItemIDs)
+	}
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Len(t, res.Items, 20)
+	assert.Empty(t, res.Failures)
+}
+
+func TestChunkStrings(t *testing.T) {
+	assert.Nil(t, chunkStrings(nil, 2))
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, chunkStrings([]string{"a", "b", "c"}, 2))
+	assert.Equal(t, []string{"b", "a"}, uniqueStrings([]string{"b", "", "a", "b"}))
+}
+
+func TestService_GetItemStatuses(t *testing.T) {
+	var calls int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&calls, 1)
+		b, _ := ioutil.ReadAll(r.Body)
+		req := GetItemStatusRequest{}
+		if !assert.NoError(t, xml.Unmarshal(b, &req)) {
+			return
+		}
+		assert.True(t, len(req.ItemIDs) <= MaxItemIDsPerCall)
+
+		sb := strings.Builder{}
+		for _, id := range req.ItemIDs {
+			if strings.HasPrefix(id, "gone") {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf(`<StatusItem><ItemID>%s</ItemID><ListingStatus>Active</ListingStatus></StatusItem>`, id))
+		}
+		_, _ = fmt.Fprintf(w, `<GetItemStatusResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>Success</Ack>%s</GetItemStatusResponse>`,
+			sb.String())
+	}))
+	defer server.Close()
+	service := NewService("").WithEndpoint(server.URL).WithBatchConcurrency(3)
+
+	var ids []string
+	for i := 0; i < 95; i++ {
+		ids = append(ids, fmt.Sprintf("%d", i))
+	}
+	ids = append(ids, "gone2", "1", "gone1")
+
+	res, err := service.GetItemStatuses(context.Background(), ids)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, int64(5), atomic.LoadInt64(&calls))
+	assert.Len(t, res.ItemIDs, 97)
+	assert.Equal(t, ids[:95], res.ItemIDs[:95])
+	assert.Len(t, res.Items, 95)
+	assert.Equal(t, "Active", res.Items["42"].ListingStatus)
+	assert.Equal(t, []string{"gone2", "gone1"}, res.Missing)
+}
+
+func TestGetItemStatusRequest_WithItemIDs(t *testing.T) {
+	req := NewService("").NewGetItemStatusRequest().WithItemIDs("3", "1", "2", "1")
+	assert.Equal(t, []string{"3", "1", "2"}, req.ItemIDs)
+}
@@ -0,0 +1,281 @@
+package shopping
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Cache stores raw response bodies of successful calls (see Service.WithCache).
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value of the key if it is present and has not expired
+	Get(key string) ([]byte, bool)
+	// Set stores the value for ttl
+	Set(key string, value []byte, ttl time.Duration)
+	// Delete removes the key
+	Delete(key string)
+}
+
+// DefaultCacheTTLs are TTLs of cached responses per operation used by Service.WithCache.
+// Operations without TTL (e.g. GeteBayTime) are not cached.
+var DefaultCacheTTLs = map[EbayOperation]time.Duration{
+	OperationFindProducts:     time.Hour,
+	OperationGetCategoryInfo:  24 * time.Hour,
+	OperationGetItemStatus:    30 * time.Second,
+	OperationGetMultipleItems: 5 * time.Minute,
+	OperationGetShippingCosts: 10 * time.Minute,
+	OperationGetSingleItem:    5 * time.Minute,
+	OperationGetUserProfile:   time.Hour,
+}
+
+// CacheStats contains cache counters of an operation
+type CacheStats struct {
+	Hits   int64
+	Misses int64
+	// Sets is the number of stored responses
+	Sets int64
+}
+
+// responseCache is a Cache with per-operation TTLs and counters.
+// Caching is disabled if cache is nil.
+type responseCache struct {
+	cache Cache
+	mu    sync.Mutex
+	ttls  map[EbayOperation]time.Duration
+	stats map[EbayOperation]CacheStats
+}
+
+func newResponseCache() *responseCache {
+	ttls := make(map[EbayOperation]time.Duration, len(DefaultCacheTTLs))
+	for op, ttl := range DefaultCacheTTLs {
+		ttls[op] = ttl
+	}
+	return &responseCache{
+		ttls:  ttls,
+		stats: make(map[EbayOperation]CacheStats),
+	}
+}
+
+// requestKey returns key of the request body used for caching and deduplication.
+// Requests for different endpoints (e.g. sandbox and production), API versions and sites have different keys.
+func requestKey(endpoint, version string, operation EbayOperation, siteID string, encoding Encoding, body []byte) string {
+	h := sha256.New()
+	for _, s := range []string{endpoint, version, string(operation), siteID, string(encoding)} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// enabled checks if responses of the operation are cached
+func (c *responseCache) enabled(operation EbayOperation) bool {
+	return c.cache != nil && c.ttl(operation) > 0
+}
+
+// ttl returns TTL of the operation
+func (c *responseCache) ttl(operation EbayOperation) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ttls[operation]
+}
+
+// setTTL changes TTL of the operation. Zero TTL disables caching of the operation.
+func (c *responseCache) setTTL(operation EbayOperation, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttls[operation] = ttl
+}
+
+// get returns cached response body and counts a hit or a miss
+func (c *responseCache) get(operation EbayOperation, key string) ([]byte, bool) {
+	value, ok := c.cache.Get(key)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats[operation]
+	if ok {
+		stats.Hits++
+	} else {
+		stats.Misses++
+	}
+	c.stats[operation] = stats
+	return value, ok
+}
+
+// set stores response body with TTL of the operation
+func (c *responseCache) set(operation EbayOperation, key string, value []byte) {
+	c.cache.Set(key, value, c.ttl(operation))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats[operation]
+	stats.Sets++
+	c.stats[operation] = stats
+}
+
+// snapshot returns copy of counters
+func (c *responseCache) snapshot() map[EbayOperation]CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := make(map[EbayOperation]CacheStats, len(c.stats))
+	for op, s := range c.stats {
+		stats[op] = s
+	}
+	return stats
+}
+
+/*
+==============================================================
+*/
+
+// LRUCache is an in-memory Cache which keeps up to maxEntries least recently used entries
+type LRUCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List
+	now        func() time.Time
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRUCache creates new LRUCache. Zero maxEntries means no limit.
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// Get implements Cache
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*lruEntry)
+	if !c.now().Before(entry.expires) {
+		c.remove(el)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return entry.value, true
+}
+
+// Set implements Cache
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expires := c.now().Add(ttl)
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*lruEntry)
+		entry.value = value
+		entry.expires = expires
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value, expires: expires})
+	if c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+// Delete implements Cache
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+}
+
+// Len returns the number of entries including expired ones which have not been evicted yet
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *LRUCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*lruEntry).key)
+}
+
+/*
+==============================================================
+*/
+
+// FileCache is a Cache which stores entries as files in a directory.
+// It can be shared by several processes. Errors of the file system are treated as cache misses.
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache creates new FileCache which stores entries in dir. The directory is created if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir, now: time.Now}, nil
+}
+
+// path returns file name of the key
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements Cache
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	b, err := os.ReadFile(c.path(key))
+	if err != nil || len(b) < 8 {
+		return nil, false
+	}
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(b[:8])))
+	if !c.now().Before(expires) {
+		c.Delete(key)
+		return nil, false
+	}
+	return b[8:], true
+}
+
+// Set implements Cache. The file is written atomically.
+func (c *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	b := make([]byte, 8, 8+len(value))
+	binary.BigEndian.PutUint64(b, uint64(c.now().Add(ttl).UnixNano()))
+	b = append(b, value...)
+
+	f, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), c.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+}
+
+// Delete implements Cache
+func (c *FileCache) Delete(key string) {
+	_ = os.Remove(c.path(key))
+}
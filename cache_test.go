@@ -0,0 +1,111 @@
+package shopping
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRUCache(t *testing.T) {
+	now := time.Date(2021, 11, 27, 0, 0, 0, 0, time.UTC)
+	c := NewLRUCache(2)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), time.Hour)
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+	// "b" is the least recently used one
+	c.Set("c", []byte("3"), time.Hour)
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, c.Len())
+
+	now = now.Add(2 * time.Minute)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	v, ok := c.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("3"), v)
+
+	c.Delete("c")
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestFileCache(t *testing.T) {
+	now := time.Date(2021, 11, 27, 0, 0, 0, 0, time.UTC)
+	c, err := NewFileCache(t.TempDir())
+	if !assert.NoError(t, err) {
+		return
+	}
+	c.now = func() time.Time { return now }
+
+	c.Set("key/with spaces", []byte("<Ack>Success</Ack>"), time.Minute)
+	v, ok := c.Get("key/with spaces")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("<Ack>Success</Ack>"), v)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("key/with spaces")
+	assert.False(t, ok)
+
+	c.Set("k", []byte("v"), time.Minute)
+	c.Delete("k")
+	_, ok = c.Get("k")
+	assert.False(t, ok)
+}
+
+func TestService_WithCache(t *testing.T) {
+	var calls int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&calls, 1)
+		ack := AckSuccess
+		if r.Header.Get("X-EBAY-API-SITE-ID") == string(SiteIDEbayDE) {
+			ack = AckFailure
+		}
+		_, _ = fmt.Fprintf(w, `<GetSingleItemResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>%s</Ack>`+
+			`<Item><ItemID>1</ItemID></Item></GetSingleItemResponse>`, ack)
+	}))
+	defer server.Close()
+	service := NewService("").WithEndpoint(server.URL).WithCache(NewLRUCache(10))
+
+	for i := 0; i < 3; i++ {
+		res, err := service.NewGetSingleItemRequest().WithItemID("1").ExecuteContext(context.Background())
+		if assert.NoError(t, err) {
+			assert.Equal(t, "1", res.Item.ItemID)
+		}
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 1, Sets: 1}, service.CacheStats()[OperationGetSingleItem])
+
+	// failures are not cached
+	service.WithSiteID(SiteIDEbayDE)
+	for i := 0; i < 2; i++ {
+		_, err := service.NewGetSingleItemRequest().WithItemID("1").ExecuteContext(context.Background())
+		assert.Error(t, err)
+	}
+	assert.Equal(t, int64(3), atomic.LoadInt64(&calls))
+
+	// disabled operation
+	service.WithSiteID(SiteIDEbayUS).WithCacheTTL(OperationGetSingleItem, 0)
+	_, err := service.NewGetSingleItemRequest().WithItemID("1").ExecuteContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), atomic.LoadInt64(&calls))
+}
+
+func TestRequestKey(t *testing.T) {
+	body := []byte("<GetSingleItemRequest><ItemID>1</ItemID></GetSingleItemRequest>")
+	key := requestKey(EbayEndpointProduction, EbayShoppingAPIVersion, OperationGetSingleItem, "0", EncodingXML, body)
+	assert.Equal(t, key, requestKey(EbayEndpointProduction, EbayShoppingAPIVersion, OperationGetSingleItem, "0", EncodingXML, body))
+	assert.NotEqual(t, key, requestKey(EbayEndpointProduction, EbayShoppingAPIVersion, OperationGetSingleItem, "77", EncodingXML, body))
+	assert.NotEqual(t, key, requestKey(EbayEndpointSandbox, EbayShoppingAPIVersion, OperationGetSingleItem, "0", EncodingXML, body))
+	assert.NotEqual(t, key, requestKey(EbayEndpointProduction, "1000", OperationGetSingleItem, "0", EncodingXML, body))
+	assert.False(t, strings.ContainsAny(key, "/<"))
+}
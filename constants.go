@@ -1,10 +1,35 @@
 package shopping
 
+import "time"
+
 const EbayShoppingAPIVersion = "1199"
 const EbayRequestDataFormat = "XML"
 const EbayResponseDataFormat = "XML"
+
+// Encoding is a data format of requests and responses (see Service.WithEncoding)
+type Encoding string
+
+const (
+	EncodingXML  Encoding = "XML"
+	EncodingJSON Encoding = "JSON"
+)
 const DefaultItemsPerPage = 100
 
+// MaxItemIDsPerCall is a maximum number of ItemID values in one GetMultipleItems or GetItemStatus call
+const MaxItemIDsPerCall = 20
+
+// DefaultBatchConcurrency is a default number of concurrent calls made by batch methods of Service
+const DefaultBatchConcurrency = 4
+
+const (
+	// DefaultMaxIdleConns is a default maximum number of idle connections kept by Service
+	DefaultMaxIdleConns = 100
+	// DefaultMaxIdleConnsPerHost is a default maximum number of idle connections to eBay kept by Service
+	DefaultMaxIdleConnsPerHost = 100
+	// DefaultIdleConnTimeout is a default time an idle connection is kept by Service
+	DefaultIdleConnTimeout = 90 * time.Second
+)
+
 type EbayEndpoint string
 
 const (
@@ -14,6 +39,17 @@ const (
 	EbayEndpointSandbox = "https://open.api.sandbox.ebay.com/shopping"
 )
 
+const (
+	// EbayOAuthEndpointProduction is a production endpoint for minting OAuth application tokens
+	EbayOAuthEndpointProduction = "https://api.ebay.com/identity/v1/oauth2/token"
+	// EbayOAuthEndpointSandbox is a sandbox endpoint for minting OAuth application tokens
+	EbayOAuthEndpointSandbox = "https://api.sandbox.ebay.com/identity/v1/oauth2/token"
+	// EbayOAuthScopePublic is a scope of application tokens which is enough for Shopping API
+	EbayOAuthScopePublic = "https://api.ebay.com/oauth/api_scope"
+	// DefaultTokenRefreshBefore is a default time before token expiry when ClientCredentialsProvider refreshes the token
+	DefaultTokenRefreshBefore = 5 * time.Minute
+)
+
 type EbayOperation string
 
 const (
@@ -27,6 +63,14 @@ const (
 	OperationGetUserProfile   EbayOperation = "GetUserProfile"
 )
 
+// Ack values returned by eBay in every response
+const (
+	AckSuccess        = "Success"
+	AckWarning        = "Warning"
+	AckFailure        = "Failure"
+	AckPartialFailure = "PartialFailure"
+)
+
 type SiteID string
 
 const (
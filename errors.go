@@ -0,0 +1,145 @@
+package shopping
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// eBay error codes which are checked by IsInvalidItemID, IsTokenExpired and IsRateLimited.
+// See more: https://developer.ebay.com/Devzone/shopping/docs/CallRef/Errors/ErrorMessages.html
+const (
+	// ErrorCodeCallLimitExceeded is returned when the application has reached its call usage limit
+	ErrorCodeCallLimitExceeded = "1.21"
+	// ErrorCodeExpiredToken is returned when IAF token has expired
+	ErrorCodeExpiredToken = "1.32"
+	// ErrorCodeInvalidToken is returned when IAF token is invalid or missing
+	ErrorCodeInvalidToken = "1.33"
+	// ErrorCodeInvalidItemID is returned when the listing does not exist or ItemID is malformed
+	ErrorCodeInvalidItemID = "10.12"
+)
+
+// ErrorClassification values
+// See more: https://developer.ebay.com/Devzone/shopping/docs/CallRef/types/ErrorClassificationCodeType.html
+const (
+	ErrorClassificationRequestError = "RequestError"
+	ErrorClassificationSystemError  = "SystemError"
+)
+
+// SeverityCode values
+// See more: https://developer.ebay.com/Devzone/shopping/docs/CallRef/types/SeverityCodeType.html
+const (
+	SeverityCodeError   = "Error"
+	SeverityCodeWarning = "Warning"
+)
+
+// APIError is returned when eBay responds with Ack Failure or PartialFailure,
+// or with HTTP status other than 200.
+// Use errors.As to get it from the error returned by Execute.
+type APIError struct {
+	Operation EbayOperation
+	// StatusCode is HTTP status code of the response
+	StatusCode    int
+	Ack           string
+	CorrelationID string
+	Errors        []Error
+	// Body is a raw response body. It is set only for non-200 responses without eBay errors.
+	Body string
+}
+
+// Error implements error interface
+func (e *APIError) Error() string {
+	b := strings.Builder{}
+	b.WriteString("ebay ")
+	b.WriteString(string(e.Operation))
+	if e.StatusCode != http.StatusOK {
+		b.WriteString(fmt.Sprintf(": status code %d", e.StatusCode))
+	}
+	if e.Ack != "" {
+		b.WriteString(": ")
+		b.WriteString(e.Ack)
+	}
+	for i, er := range e.Errors {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		b.WriteString(fmt.Sprintf("[%s] %s", er.ErrorCode, er.ShortMessage))
+	}
+	if len(e.Errors) == 0 && e.Body != "" {
+		b.WriteString(": ")
+		b.WriteString(e.Body)
+	}
+	return b.String()
+}
+
+// ErrorCodes returns codes of all errors in the response
+func (e *APIError) ErrorCodes() []string {
+	codes := make([]string, 0, len(e.Errors))
+	for _, er := range e.Errors {
+		codes = append(codes, er.ErrorCode)
+	}
+	return codes
+}
+
+// HasErrorCode checks if the response contains any of the given eBay error codes
+func (e *APIError) HasErrorCode(codes ...string) bool {
+	for _, er := range e.Errors {
+		for _, code := range codes {
+			if er.ErrorCode == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasErrorClassification checks if the response contains an error with the given classification
+func (e *APIError) HasErrorClassification(classification string) bool {
+	for _, er := range e.Errors {
+		if er.ErrorClassification == classification {
+			return true
+		}
+	}
+	return false
+}
+
+// isAPIFailure checks if err is *APIError caused by Ack Failure or PartialFailure in a decoded response
+func isAPIFailure(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusOK
+}
+
+// IsInvalidItemID checks if err is *APIError caused by invalid or nonexistent ItemID
+func IsInvalidItemID(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.HasErrorCode(ErrorCodeInvalidItemID)
+}
+
+// IsTokenExpired checks if err is *APIError caused by expired or invalid IAF token
+func IsTokenExpired(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusUnauthorized ||
+		apiErr.HasErrorCode(ErrorCodeExpiredToken, ErrorCodeInvalidToken)
+}
+
+// IsRateLimited checks if err is *APIError caused by exceeded call limit
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests ||
+		apiErr.HasErrorCode(ErrorCodeCallLimitExceeded)
+}
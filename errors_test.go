@@ -0,0 +1,145 @@
+package shopping
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testInvalidItemResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<GetSingleItemResponse xmlns="urn:ebay:apis:eBLBaseComponents">
+  <Timestamp>2021-11-27T00:28:30.123Z</Timestamp>
+  <Ack>Failure</Ack>
+  <CorrelationID>msg-1</CorrelationID>
+  <Errors>
+    <ShortMessage>Invalid item ID.</ShortMessage>
+    <LongMessage>Item ID "1" is invalid.</LongMessage>
+    <ErrorCode>10.12</ErrorCode>
+    <SeverityCode>Error</SeverityCode>
+    <ErrorParameters ParamID="0">
+      <Value>1</Value>
+    </ErrorParameters>
+    <ErrorClassification>RequestError</ErrorClassification>
+  </Errors>
+  <Build>E1199_CORE_APILW2_19110890_R1</Build>
+  <Version>1199</Version>
+</GetSingleItemResponse>`
+
+const testPartialFailureResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<GetMultipleItemsResponse xmlns="urn:ebay:apis:eBLBaseComponents">
+  <Ack>PartialFailure</Ack>
+  <Errors>
+    <ShortMessage>Invalid item ID.</ShortMessage>
+    <ErrorCode>10.12</ErrorCode>
+    <SeverityCode>Warning</SeverityCode>
+    <ErrorParameters ParamID="0">
+      <Value>2</Value>
+    </ErrorParameters>
+    <ErrorClassification>RequestError</ErrorClassification>
+  </Errors>
+  <Item>
+    <ItemID>1</ItemID>
+  </Item>
+</GetMultipleItemsResponse>`
+
+const testExpiredTokenResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<GeteBayTimeResponse xmlns="urn:ebay:apis:eBLBaseComponents">
+  <Ack>Failure</Ack>
+  <Errors>
+    <ShortMessage>Expired IAF token.</ShortMessage>
+    <ErrorCode>1.32</ErrorCode>
+    <SeverityCode>Error</SeverityCode>
+    <ErrorClassification>RequestError</ErrorClassification>
+  </Errors>
+</GeteBayTimeResponse>`
+
+func TestAPIError_Failure(t *testing.T) {
+	service := NewService("").
+		WithEndpoint("http://ebay.invalid/shopping").
+		WithTransport(newStaticTransport(http.StatusOK, testInvalidItemResponse, nil))
+
+	res, err := service.NewGetSingleItemRequest().WithItemID("1").Execute()
+	var apiErr *APIError
+	if !assert.True(t, errors.As(err, &apiErr)) {
+		return
+	}
+	assert.Equal(t, OperationGetSingleItem, apiErr.Operation)
+	assert.Equal(t, http.StatusOK, apiErr.StatusCode)
+	assert.Equal(t, AckFailure, apiErr.Ack)
+	assert.Equal(t, "msg-1", apiErr.CorrelationID)
+	assert.Equal(t, []string{ErrorCodeInvalidItemID}, apiErr.ErrorCodes())
+	assert.Equal(t, "1", apiErr.Errors[0].ErrorParameters[0].Value)
+	assert.True(t, apiErr.HasErrorClassification(ErrorClassificationRequestError))
+	assert.Equal(t, "ebay GetSingleItem: Failure: [10.12] Invalid item ID.", apiErr.Error())
+	assert.True(t, IsInvalidItemID(err))
+	assert.False(t, IsTokenExpired(err))
+	assert.False(t, IsRateLimited(err))
+	assert.Equal(t, AckFailure, res.Ack)
+}
+
+func TestAPIError_PartialFailure(t *testing.T) {
+	service := NewService("").
+		WithEndpoint("http://ebay.invalid/shopping").
+		WithTransport(newStaticTransport(http.StatusOK, testPartialFailureResponse, nil))
+
+	res, err := service.NewGetMultipleItemsRequest().WithItemID("1", "2").Execute()
+	assert.True(t, IsInvalidItemID(err))
+	if assert.Len(t, res.Items, 1) {
+		assert.Equal(t, "1", res.Items[0].ItemID)
+	}
+}
+
+func TestAPIError_Warning(t *testing.T) {
+	service := NewService("").
+		WithEndpoint("http://ebay.invalid/shopping").
+		WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, nil))
+
+	_, err := service.NewGeteBayTimeRequest().Execute()
+	assert.NoError(t, err)
+}
+
+func TestAPIError_StatusCode(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantExpired bool
+		wantLimited bool
+		wantMessage string
+	}{
+		{
+			name:        "expired token",
+			status:      http.StatusInternalServerError,
+			body:        testExpiredTokenResponse,
+			wantExpired: true,
+			wantMessage: "ebay GeteBayTime: status code 500: Failure: [1.32] Expired IAF token.",
+		},
+		{
+			name:        "too many requests",
+			status:      http.StatusTooManyRequests,
+			body:        "slow down",
+			wantLimited: true,
+			wantMessage: "ebay GeteBayTime: status code 429: slow down",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewService("").
+				WithEndpoint("http://ebay.invalid/shopping").
+				WithTransport(newStaticTransport(tt.status, tt.body, nil))
+
+			res, err := service.NewGeteBayTimeRequest().Execute()
+			var apiErr *APIError
+			if !assert.True(t, errors.As(err, &apiErr)) {
+				return
+			}
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.wantMessage, apiErr.Error())
+			assert.Equal(t, tt.wantExpired, IsTokenExpired(err))
+			assert.Equal(t, tt.wantLimited, IsRateLimited(err))
+			assert.Equal(t, GeteBayTimeResponse{}, res)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package shopping
+
+import "context"
+
+// maxFindProductsPage is the last page which can be requested by FindProductsRequest
+const maxFindProductsPage = 10000
+
+// ProductIterator walks over products of all pages of FindProductsRequest.
+//
+//	it := request.All(ctx).WithMaxProducts(500)
+//	for it.Next() {
+//		product := it.Product()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// Pages are fetched lazily until MoreResults is false, ApproximatePages is reached or the budget
+// set by WithMaxPages/WithMaxProducts is exhausted.
+type ProductIterator struct {
+	ctx         context.Context
+	request     *FindProductsRequest
+	nextPage    int
+	page        int
+	maxPages    int
+	maxProducts int
+	pages       int
+	count       int
+	more        bool
+	response    FindProductsResponse
+	products    []Product
+	index       int
+	product     Product
+	err         error
+}
+
+// All returns ProductIterator which walks over products of all pages starting from the first one.
+// The request is executed with the given context.
+func (r *FindProductsRequest) All(ctx context.Context) *ProductIterator {
+	return &ProductIterator{
+		ctx:      ctx,
+		request:  r,
+		nextPage: 1,
+		more:     true,
+	}
+}
+
+// WithStartPage changes the first page to fetch. It is used to resume iteration from a saved
+// page number (see Page). It must be called before the first call of Next.
+func (it *ProductIterator) WithStartPage(page int) *ProductIterator {
+	if page < 1 {
+		page = 1
+	}
+	it.nextPage = page
+	return it
+}
+
+// WithMaxPages limits the number of pages to fetch. Zero means no limit.
+func (it *ProductIterator) WithMaxPages(pages int) *ProductIterator {
+	it.maxPages = pages
+	return it
+}
+
+// WithMaxProducts limits the number of products to return. Zero means no limit.
+func (it *ProductIterator) WithMaxProducts(products int) *ProductIterator {
+	it.maxProducts = products
+	return it
+}
+
+// Next advances the iterator to the next product. It fetches the next page when needed.
+// It returns false when there are no more products or an error occurred (see Err).
+func (it *ProductIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.maxProducts > 0 && it.count >= it.maxProducts {
+		return false
+	}
+	for it.index >= len(it.products) {
+		if !it.more || (it.maxPages > 0 && it.pages >= it.maxPages) {
+			return false
+		}
+		if err := it.fetch(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+	it.product = it.products[it.index]
+	it.index++
+	it.count++
+	return true
+}
+
+// fetch gets the next page
+func (it *ProductIterator) fetch() error {
+	res, err := it.request.GetPageContext(it.ctx, it.nextPage)
+	if err != nil {
+		return err
+	}
+	it.response = res
+	it.products = res.Products
+	it.index = 0
+	it.page = it.nextPage
+	it.nextPage++
+	it.pages++
+	it.more = res.MoreResults && len(res.Products) > 0 && it.nextPage <= maxFindProductsPage &&
+		(res.ApproximatePages == 0 || it.nextPage <= res.ApproximatePages)
+	return nil
+}
+
+// Product returns the current product
+func (it *ProductIterator) Product() Product {
+	return it.product
+}
+
+// Page returns the page number of the current product.
+// Save it to resume iteration later with WithStartPage (products of this page will be returned again).
+func (it *ProductIterator) Page() int {
+	return it.page
+}
+
+// Response returns the response of the last fetched page
+func (it *ProductIterator) Response() FindProductsResponse {
+	return it.response
+}
+
+// Err returns the error which stopped the iteration
+func (it *ProductIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,106 @@
+package shopping
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFindProductsServer starts test server which returns pages with 2 products each.
+// MoreResults is true for all pages before the last one. The failPage fails with status 500.
+func newFindProductsServer(t *testing.T, lastPage, failPage int, pages *[]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		req := FindProductsRequest{}
+		if !assert.NoError(t, xml.Unmarshal(b, &req)) {
+			return
+		}
+		*pages = append(*pages, req.PageNumber)
+		if req.PageNumber == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		sb := strings.Builder{}
+		for i := 1; i <= 2; i++ {
+			sb.WriteString(fmt.Sprintf(`<Product><Title>p%d-%d</Title></Product>`, req.PageNumber, i))
+		}
+		_, _ = fmt.Fprintf(w, `<FindProductsResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>Success</Ack>`+
+			`<ApproximatePages>%d</ApproximatePages><MoreResults>%t</MoreResults><PageNumber>%d</PageNumber>%s</FindProductsResponse>`,
+			lastPage, req.PageNumber < lastPage, req.PageNumber, sb.String())
+	}))
+}
+
+func collectTitles(it *ProductIterator) []string {
+	var titles []string
+	for it.Next() {
+		titles = append(titles, it.Product().Title)
+	}
+	return titles
+}
+
+func TestProductIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(it *ProductIterator) *ProductIterator
+		wantPages []int
+		want      []string
+	}{
+		{
+			name:      "all pages",
+			configure: func(it *ProductIterator) *ProductIterator { return it },
+			wantPages: []int{1, 2, 3},
+			want:      []string{"p1-1", "p1-2", "p2-1", "p2-2", "p3-1", "p3-2"},
+		},
+		{
+			name:      "max products",
+			configure: func(it *ProductIterator) *ProductIterator { return it.WithMaxProducts(3) },
+			wantPages: []int{1, 2},
+			want:      []string{"p1-1", "p1-2", "p2-1"},
+		},
+		{
+			name:      "max pages",
+			configure: func(it *ProductIterator) *ProductIterator { return it.WithMaxPages(1) },
+			wantPages: []int{1},
+			want:      []string{"p1-1", "p1-2"},
+		},
+		{
+			name:      "resume",
+			configure: func(it *ProductIterator) *ProductIterator { return it.WithStartPage(3) },
+			wantPages: []int{3},
+			want:      []string{"p3-1", "p3-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pages []int
+			server := newFindProductsServer(t, 3, 0, &pages)
+			defer server.Close()
+
+			req := NewService("").WithEndpoint(server.URL).NewFindProductsRequest().WithQueryKeywords("Harry Potter")
+			it := tt.configure(req.All(context.Background()))
+			assert.Equal(t, tt.want, collectTitles(it))
+			assert.NoError(t, it.Err())
+			assert.Equal(t, tt.wantPages, pages)
+		})
+	}
+}
+
+func TestProductIterator_Error(t *testing.T) {
+	var pages []int
+	server := newFindProductsServer(t, 3, 2, &pages)
+	defer server.Close()
+
+	req := NewService("").WithEndpoint(server.URL).NewFindProductsRequest().WithQueryKeywords("Harry Potter")
+	it := req.All(context.Background())
+	assert.Equal(t, []string{"p1-1", "p1-2"}, collectTitles(it))
+	assert.Error(t, it.Err())
+	assert.Equal(t, 1, it.Page())
+	assert.False(t, it.Next())
+}
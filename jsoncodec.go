@@ -0,0 +1,432 @@
+package shopping
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// JSON encoding of eBay Shopping API mirrors its XML encoding:
+//   - elements become object fields with the same names, repeated elements become arrays;
+//   - attributes become fields with capitalized names (currencyID -> CurrencyID);
+//   - text of an element with attributes becomes Value field.
+//
+// Requests are described only by xml tags, so JSON is encoded by walking the types using their xml tags.
+// Responses have json tags in addition to xml tags and are decoded by encoding/json directly.
+
+var xmlMarshalerType = reflect.TypeOf((*xml.Marshaler)(nil)).Elem()
+
+// xmlField is a struct field described by its xml tag
+type xmlField struct {
+	index     []int
+	path      []string
+	attr      bool
+	chardata  bool
+	omitempty bool
+}
+
+// jsonName returns JSON field name of the xml attribute or element
+func (f xmlField) jsonName() string {
+	if f.chardata {
+		return "Value"
+	}
+	name := f.path[len(f.path)-1]
+	if f.attr {
+		r, size := utf8.DecodeRuneInString(name)
+		return string(unicode.ToUpper(r)) + name[size:]
+	}
+	return name
+}
+
+// xmlFields returns fields of struct type t including fields of embedded structs
+func xmlFields(t reflect.Type) []xmlField {
+	var fields []xmlField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("xml")
+		if tag == "-" || sf.Name == "XMLName" {
+			continue
+		}
+		if sf.Anonymous && tag == "" && sf.Type.Kind() == reflect.Struct {
+			for _, f := range xmlFields(sf.Type) {
+				f.index = append([]int{i}, f.index...)
+				fields = append(fields, f)
+			}
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		f := xmlField{index: []int{i}}
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+		if name == "" {
+			name = sf.Name
+		}
+		for _, flag := range parts[1:] {
+			switch flag {
+			case "attr":
+				f.attr = true
+			case "chardata", "cdata":
+				f.chardata = true
+			case "omitempty":
+				f.omitempty = true
+			}
+		}
+		f.path = strings.Split(name, ">")
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+/*
+==============================================================
+*/
+
+// marshalJSON encodes v (request) as eBay JSON
+func marshalJSON(v interface{}) ([]byte, error) {
+	j, err := toJSONValue(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(j)
+}
+
+// toJSONValue converts v into value which can be encoded by encoding/json
+func toJSONValue(v reflect.Value) (interface{}, error) {
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		return toJSONValue(v.Elem())
+	}
+	if v.Type().Implements(xmlMarshalerType) {
+		return xmlMarshalerToJSON(v.Interface().(xml.Marshaler))
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		obj := map[string]interface{}{}
+		for _, f := range xmlFields(v.Type()) {
+			fv := v.FieldByIndex(f.index)
+			if f.omitempty && fv.IsZero() {
+				continue
+			}
+			j, err := toJSONValue(fv)
+			if err != nil {
+				return nil, err
+			}
+			if j == nil {
+				continue
+			}
+			target := obj
+			if !f.attr && !f.chardata {
+				for _, p := range f.path[:len(f.path)-1] {
+					child, ok := target[p].(map[string]interface{})
+					if !ok {
+						child = map[string]interface{}{}
+						target[p] = child
+					}
+					target = child
+				}
+			}
+			target[f.jsonName()] = j
+		}
+		return obj, nil
+	case reflect.Slice, reflect.Array:
+		list := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			j, err := toJSONValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, j)
+		}
+		return list, nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// xmlMarshalerToJSON converts value which has custom XML encoding (e.g. Money) into JSON value
+func xmlMarshalerToJSON(m xml.Marshaler) (interface{}, error) {
+	b, err := xml.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	d := xml.NewDecoder(bytes.NewReader(b))
+	var start xml.StartElement
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := tok.(xml.StartElement); ok {
+			start = s
+			break
+		}
+	}
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return nil, err
+	}
+	if len(start.Attr) == 0 {
+		return text, nil
+	}
+	obj := map[string]interface{}{"Value": text}
+	for _, attr := range start.Attr {
+		obj[xmlField{path: []string{attr.Name.Local}, attr: true}.jsonName()] = attr.Value
+	}
+	return obj, nil
+}
+
+/*
+==============================================================
+*/
+
+// unmarshalJSON decodes eBay JSON into v (pointer to response).
+// Responses have json tags with the same names as their xml tags,
+// fields with xml paths (e.g. ItemSpecifics>NameValueList) are decoded by UnmarshalJSON of their structs
+// and encoded by their MarshalJSON, so responses encoded by encoding/json can be decoded back.
+func unmarshalJSON(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// nameValueListsJSON is JSON container of NameValueList elements (e.g. ItemSpecifics)
+type nameValueListsJSON struct {
+	NameValueList []NameValueList
+}
+
+// list returns the elements of the container, c may be nil
+func (c *nameValueListsJSON) list() []NameValueList {
+	if c == nil {
+		return nil
+	}
+	return c.NameValueList
+}
+
+// newNameValueListsJSON returns the container of list, it is nil for empty list
+func newNameValueListsJSON(list []NameValueList) *nameValueListsJSON {
+	if len(list) == 0 {
+		return nil
+	}
+	return &nameValueListsJSON{NameValueList: list}
+}
+
+// MarshalJSON implements json.Marshaler
+func (p Product) MarshalJSON() ([]byte, error) {
+	type product Product
+	return json.Marshal(struct {
+		product
+		ItemSpecifics *nameValueListsJSON `json:",omitempty"`
+	}{product(p), newNameValueListsJSON(p.ItemSpecifics)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *Product) UnmarshalJSON(data []byte) error {
+	type product Product
+	aux := struct {
+		*product
+		ItemSpecifics *nameValueListsJSON
+	}{product: (*product)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	p.ItemSpecifics = aux.ItemSpecifics.list()
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (i *Item) UnmarshalJSON(data []byte) error {
+	type item Item
+	aux := struct {
+		*item
+		ItemSpecifics *nameValueListsJSON
+		QuantityInfo  *struct {
+			MinimumRemnantSet int
+		}
+	}{item: (*item)(i)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	i.ItemSpecifics = aux.ItemSpecifics.list()
+	if aux.QuantityInfo != nil {
+		i.MinimumRemnantSet = aux.QuantityInfo.MinimumRemnantSet
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (i Item) MarshalJSON() ([]byte, error) {
+	type item Item
+	aux := struct {
+		item
+		ItemSpecifics *nameValueListsJSON `json:",omitempty"`
+		QuantityInfo  *struct {
+			MinimumRemnantSet int
+		} `json:",omitempty"`
+	}{item: item(i), ItemSpecifics: newNameValueListsJSON(i.ItemSpecifics)}
+	if i.MinimumRemnantSet != 0 {
+		aux.QuantityInfo = &struct {
+			MinimumRemnantSet int
+		}{i.MinimumRemnantSet}
+	}
+	return json.Marshal(aux)
+}
+
+// MarshalJSON implements json.Marshaler.
+// Fields of Item and ItemExtended are encoded separately and merged into one object,
+// because ItemExtended would get MarshalJSON of the embedded Item otherwise.
+func (i ItemExtended) MarshalJSON() ([]byte, error) {
+	type compatibilityList struct {
+		Compatibility []Compatibility
+	}
+	extended := struct {
+		ItemCompatibilityCount int
+		ItemCompatibilityList  *compatibilityList `json:",omitempty"`
+	}{ItemCompatibilityCount: i.ItemCompatibilityCount}
+	if len(i.ItemCompatibilityList) > 0 {
+		extended.ItemCompatibilityList = &compatibilityList{Compatibility: i.ItemCompatibilityList}
+	}
+	fields := map[string]json.RawMessage{}
+	for _, v := range []interface{}{i.Item, extended} {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(b, &fields); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It is needed because ItemExtended would get UnmarshalJSON of the embedded Item otherwise.
+func (i *ItemExtended) UnmarshalJSON(data []byte) error {
+	if err := i.Item.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	aux := struct {
+		ItemCompatibilityCount *int
+		ItemCompatibilityList  *struct {
+			Compatibility []Compatibility
+		}
+	}{ItemCompatibilityCount: &i.ItemCompatibilityCount}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.ItemCompatibilityList != nil {
+		i.ItemCompatibilityList = aux.ItemCompatibilityList.Compatibility
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (v Variations) MarshalJSON() ([]byte, error) {
+	type variations Variations
+	return json.Marshal(struct {
+		variations
+		VariationSpecificsSet *nameValueListsJSON `json:",omitempty"`
+	}{variations(v), newNameValueListsJSON(v.VariationSpecificsSet)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (v *Variations) UnmarshalJSON(data []byte) error {
+	type variations Variations
+	aux := struct {
+		*variations
+		VariationSpecificsSet *nameValueListsJSON
+	}{variations: (*variations)(v)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	v.VariationSpecificsSet = aux.VariationSpecificsSet.list()
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (v Variation) MarshalJSON() ([]byte, error) {
+	type variation Variation
+	return json.Marshal(struct {
+		variation
+		VariationSpecifics *nameValueListsJSON `json:",omitempty"`
+	}{variation(v), newNameValueListsJSON(v.VariationSpecifics)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (v *Variation) UnmarshalJSON(data []byte) error {
+	type variation Variation
+	aux := struct {
+		*variation
+		VariationSpecifics *nameValueListsJSON
+	}{variation: (*variation)(v)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	v.VariationSpecifics = aux.VariationSpecifics.list()
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (d ShippingDetails) MarshalJSON() ([]byte, error) {
+	type shippingDetails ShippingDetails
+	aux := struct {
+		shippingDetails
+		TaxTable *TaxTable `json:",omitempty"`
+	}{shippingDetails: shippingDetails(d)}
+	if len(d.TaxTable) > 0 {
+		aux.TaxTable = &TaxTable{TaxJurisdictions: d.TaxTable}
+	}
+	return json.Marshal(aux)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *ShippingDetails) UnmarshalJSON(data []byte) error {
+	type shippingDetails ShippingDetails
+	aux := struct {
+		*shippingDetails
+		TaxTable *TaxTable
+	}{shippingDetails: (*shippingDetails)(d)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.TaxTable != nil {
+		d.TaxTable = aux.TaxTable.TaxJurisdictions
+	}
+	return nil
+}
+
+// jsonText returns text of JSON string or number (e.g. value of Money).
+// It returns false for null.
+func jsonText(data []byte) (string, bool, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return "", false, nil
+	}
+	if data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", false, err
+		}
+		return s, true, nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return "", false, err
+	}
+	return n.String(), true, nil
+}
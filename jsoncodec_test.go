@@ -0,0 +1,210 @@
+package shopping
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var codecOperations = []struct {
+	dir      string
+	request  func() interface{}
+	response func() standardResponse
+}{
+	{"findproducts", func() interface{} { return &FindProductsRequest{} }, func() standardResponse { return &FindProductsResponse{} }},
+	{"categoryinfo", func() interface{} { return &GetCategoryInfoRequest{} }, func() standardResponse { return &GetCategoryInfoResponse{} }},
+	{"ebaytime", func() interface{} { return &GeteBayTimeRequest{} }, func() standardResponse { return &GeteBayTimeResponse{} }},
+	{"itemstatus", func() interface{} { return &GetItemStatusRequest{} }, func() standardResponse { return &GetItemStatusResponse{} }},
+	{"multipleitems", func() interface{} { return &GetMultipleItemsRequest{} }, func() standardResponse { return &GetMultipleItemsResponse{} }},
+	{"shippingcosts", func() interface{} { return &GetShippingCostsRequest{} }, func() standardResponse { return &GetShippingCostsResponse{} }},
+	{"singleitem", func() interface{} { return &GetSingleItemRequest{} }, func() standardResponse { return &GetSingleItemResponse{} }},
+	{"userprofile", func() interface{} { return &GetUserProfileRequest{} }, func() standardResponse { return &GetUserProfileResponse{} }},
+}
+
+func TestMarshalJSON_Requests(t *testing.T) {
+	for _, op := range codecOperations {
+		t.Run(op.dir, func(t *testing.T) {
+			b, err := ioutil.ReadFile(path.Join("testdata", "request", "xml", op.dir, "Basic.xml"))
+			if !assert.NoError(t, err) {
+				return
+			}
+			req := op.request()
+			if !assert.NoError(t, xml.Unmarshal(b, req)) {
+				return
+			}
+			want, err := ioutil.ReadFile(path.Join("testdata", "request", "json", op.dir, "Basic.json"))
+			if !assert.NoError(t, err) {
+				return
+			}
+			got, err := marshalJSON(req)
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, string(want), string(got))
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON_Responses(t *testing.T) {
+	for _, op := range codecOperations {
+		t.Run(op.dir, func(t *testing.T) {
+			b, err := ioutil.ReadFile(path.Join("testdata", "response", "xml", op.dir, "Basic.xml"))
+			if !assert.NoError(t, err) {
+				return
+			}
+			want := op.response()
+			if !assert.NoError(t, xml.Unmarshal(b, want)) {
+				return
+			}
+			b, err = ioutil.ReadFile(path.Join("testdata", "response", "json", op.dir, "Basic.json"))
+			if !assert.NoError(t, err) {
+				return
+			}
+			got := op.response()
+			if !assert.NoError(t, unmarshalJSON(b, got)) {
+				return
+			}
+			assert.Equal(t, "1199", got.standard().Version)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestMarshalJSON_ResponsesRoundTrip(t *testing.T) {
+	for _, op := range codecOperations {
+		t.Run(op.dir, func(t *testing.T) {
+			b, err := ioutil.ReadFile(path.Join("testdata", "response", "xml", op.dir, "Basic.xml"))
+			if !assert.NoError(t, err) {
+				return
+			}
+			want := op.response()
+			if !assert.NoError(t, xml.Unmarshal(b, want)) {
+				return
+			}
+			b, err = json.Marshal(want)
+			if !assert.NoError(t, err) {
+				return
+			}
+			got := op.response()
+			if assert.NoError(t, unmarshalJSON(b, got)) {
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestMarshalJSON_ItemRoundTrip(t *testing.T) {
+	b, err := ioutil.ReadFile(path.Join("testdata", "response", "xml", "singleitem", "Basic.xml"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	res := GetSingleItemResponse{}
+	if !assert.NoError(t, xml.Unmarshal(b, &res)) {
+		return
+	}
+	want := res.Item
+	want.ItemSpecifics = []NameValueList{{Name: "Author", Values: []string{"J. K. Rowling"}}}
+	want.MinimumRemnantSet = 2
+	want.CurrentPrice = decodeMoney("10.005", "USD")
+	want.ConvertedCurrentPrice = decodeMoney("n/a", "USD")
+	want.ShippingCostSummary.ShippingServiceCost = NewMoney(1500, "JPY")
+	want.TimeLeft, err = ParseEbayDuration("P1DT2H")
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, want.Variations.Variations) {
+		assert.NotEmpty(t, want.Variations.Variations[0].VariationSpecifics)
+	}
+	assert.NotEmpty(t, want.ItemCompatibilityList)
+	assert.NotEmpty(t, want.EndTime.Raw)
+
+	b, err = json.Marshal(want)
+	if !assert.NoError(t, err) {
+		return
+	}
+	got := ItemExtended{}
+	if assert.NoError(t, json.Unmarshal(b, &got)) {
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestUnmarshalJSON_Money(t *testing.T) {
+	res := GetSingleItemResponse{}
+	err := unmarshalJSON([]byte(`{"Item":{"CurrentPrice":{"Value":19.9,"CurrencyID":"EUR"},"TimeLeft":"PT1H"}}`), &res)
+	if assert.NoError(t, err) {
+		assert.Equal(t, NewMoney(1990, "EUR"), res.Item.CurrentPrice)
+		assert.Equal(t, "PT1H", res.Item.TimeLeft.Raw)
+	}
+	res = GetSingleItemResponse{}
+	err = unmarshalJSON([]byte(`{"Item":{"CurrentPrice":{"Value":19.999,"CurrencyID":"EUR"}}}`), &res)
+	if assert.NoError(t, err) {
+		assert.Equal(t, Money{Amount: 2000, Currency: "EUR", Raw: "19.999"}, res.Item.CurrentPrice)
+	}
+	res = GetSingleItemResponse{}
+	err = unmarshalJSON([]byte(`{"Item":{"CurrentPrice":{"Value":"7.5","CurrencyID":"USD"},"EndTime":null}}`), &res)
+	if assert.NoError(t, err) {
+		assert.Equal(t, NewMoney(750, "USD"), res.Item.CurrentPrice)
+		assert.True(t, res.Item.EndTime.IsZero())
+	}
+	res = GetSingleItemResponse{}
+	err = unmarshalJSON([]byte(`{"Item":{"EndTime":"yesterday"}}`), &res)
+	assert.Error(t, err)
+}
+
+func TestService_WithEncodingJSON(t *testing.T) {
+	response, err := ioutil.ReadFile(path.Join("testdata", "response", "json", "multipleitems", "Basic.json"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "JSON", r.Header.Get("X-EBAY-API-REQUEST-ENCODING"))
+		assert.Equal(t, "JSON", r.Header.Get("X-EBAY-API-RESPONSE-ENCODING"))
+		b, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"ItemID":["124954286424","124954286425"],"IncludeSelector":"Details"}`, string(b))
+		_, _ = w.Write(response)
+	}))
+	defer server.Close()
+
+	service := NewService("").WithEndpoint(server.URL).WithEncoding(EncodingJSON)
+	res, err := service.NewGetMultipleItemsRequest().
+		WithItemIDs("124954286424", "124954286425").
+		WithIncludeSelector(IncludeSelectorMIDetails).
+		ExecuteContext(context.Background())
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, res.Items, 2) {
+		assert.Equal(t, NewMoney(2550, "USD"), res.Items[0].ConvertedCurrentPrice)
+		assert.Equal(t, 1, res.Items[0].MinimumRemnantSet)
+	}
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	codecs := []struct {
+		name      string
+		file      string
+		unmarshal func([]byte, interface{}) error
+	}{
+		{"XML", path.Join("testdata", "response", "xml", "multipleitems", "Basic.xml"), xml.Unmarshal},
+		{"JSON", path.Join("testdata", "response", "json", "multipleitems", "Basic.json"), unmarshalJSON},
+	}
+	for _, codec := range codecs {
+		data, err := ioutil.ReadFile(codec.file)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(codec.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				res := GetMultipleItemsResponse{}
+				if err := codec.unmarshal(data, &res); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package shopping
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// InsuranceOption values
+// See more: https://developer.ebay.com/Devzone/shopping/docs/CallRef/types/InsuranceOptionCodeType.html
+const (
+	InsuranceOptionIncludedInShippingHandling = "IncludedInShippingHandling"
+	InsuranceOptionNotOffered                 = "NotOffered"
+	InsuranceOptionOptional                   = "Optional"
+	InsuranceOptionRequired                   = "Required"
+)
+
+// ErrNoShippingService is returned by LandedCost when the shipping response has no shipping service
+// available to the destination
+var ErrNoShippingService = errors.New("no shipping service available")
+
+// LandedCostBreakdown is an itemized total cost of buying the item and shipping it to the destination
+type LandedCostBreakdown struct {
+	ShippingServiceName string
+	Quantity            int
+	// Item is the item price multiplied by quantity
+	Item Money
+	// Shipping is the cost of shipping the first item
+	Shipping Money
+	// AdditionalShipping is the cost of shipping the other items
+	AdditionalShipping Money
+	// Insurance is included only if it is required by the seller
+	Insurance Money
+	Import    Money
+	SalesTax  Money
+	Total     Money
+}
+
+// LandedCost calculates total cost of buying quantity units of the item with the cheapest shipping service
+// from shipping response. The shipping response must be requested for the same item and destination
+// (with IncludeDetails to get all service options and sales tax).
+// Sales tax is applied to the item price and, if ShippingIncludedInTax is set, to the shipping cost.
+// CurrencyMismatchError is returned if amounts have different currencies
+// and ErrMoneyOverflow if an amount does not fit into int64 minor units.
+func LandedCost(item Item, shipping GetShippingCostsResponse, quantity int) (LandedCostBreakdown, error) {
+	if quantity < 1 {
+		return LandedCostBreakdown{}, fmt.Errorf("invalid quantity %d", quantity)
+	}
+	price := item.ConvertedCurrentPrice
+	if price.IsZero() && price.Currency == "" {
+		price = item.CurrentPrice
+	}
+
+	var best *LandedCostBreakdown
+	for _, option := range landedCostOptions(shipping) {
+		b := option
+		b.Quantity = quantity
+		var err error
+		if b.Item, err = price.Mul(quantity); err != nil {
+			return LandedCostBreakdown{}, fmt.Errorf("item price: %w", err)
+		}
+		if quantity > 1 {
+			if b.AdditionalShipping, err = b.AdditionalShipping.Mul(quantity - 1); err != nil {
+				return LandedCostBreakdown{}, fmt.Errorf("shipping service %q: %w", b.ShippingServiceName, err)
+			}
+		} else {
+			b.AdditionalShipping = Money{}
+		}
+		if err := b.calculate(shipping.ShippingDetails.SalesTax); err != nil {
+			return LandedCostBreakdown{}, fmt.Errorf("shipping service %q: %w", b.ShippingServiceName, err)
+		}
+		if best == nil {
+			best = &b
+			continue
+		}
+		cmp, err := b.Total.Cmp(best.Total)
+		if err != nil {
+			return LandedCostBreakdown{}, fmt.Errorf("shipping service %q: %w", b.ShippingServiceName, err)
+		}
+		if cmp < 0 {
+			best = &b
+		}
+	}
+	if best == nil {
+		return LandedCostBreakdown{}, ErrNoShippingService
+	}
+	return *best, nil
+}
+
+// landedCostOptions returns shipping parts of the breakdown for every available shipping service.
+// AdditionalShipping contains the cost of one additional item.
+// ShippingCostSummary is used if the response has no service details.
+func landedCostOptions(shipping GetShippingCostsResponse) []LandedCostBreakdown {
+	details := shipping.ShippingDetails
+	var options []LandedCostBreakdown
+	for _, o := range details.ShippingServiceOptions {
+		insurance := o.ShippingInsuranceCost
+		if insurance.IsZero() {
+			insurance = details.InsuranceCost
+		}
+		options = append(options, LandedCostBreakdown{
+			ShippingServiceName: o.ShippingServiceName,
+			Shipping:            o.ShippingServiceCost,
+			AdditionalShipping:  o.ShippingServiceAdditionalCost,
+			Insurance:           requiredInsurance(details.InsuranceOption, insurance),
+		})
+	}
+	for _, o := range details.InternationalShippingServiceOptions {
+		options = append(options, LandedCostBreakdown{
+			ShippingServiceName: o.ShippingServiceName,
+			Shipping:            o.ShippingServiceCost,
+			AdditionalShipping:  o.ShippingServiceAdditionalCost,
+			Insurance:           requiredInsurance(details.InternationalInsuranceOption, details.InternationalInsuranceCost),
+			Import:              o.ImportCharge,
+		})
+	}
+	if len(options) == 0 && shipping.ShippingCostSummary.ShippingServiceName != "" {
+		summary := shipping.ShippingCostSummary
+		options = append(options, LandedCostBreakdown{
+			ShippingServiceName: summary.ShippingServiceName,
+			Shipping:            summary.ShippingServiceCost,
+			Insurance:           requiredInsurance(summary.InsuranceOption, summary.InsuranceCost),
+			Import:              summary.ImportCharge,
+		})
+	}
+	return options
+}
+
+// requiredInsurance returns insurance cost if the insurance is required
+func requiredInsurance(option string, cost Money) Money {
+	if option == InsuranceOptionRequired {
+		return cost
+	}
+	return Money{}
+}
+
+// calculate sets SalesTax and Total
+func (b *LandedCostBreakdown) calculate(tax SalesTax) error {
+	shipping, err := b.Shipping.Add(b.AdditionalShipping)
+	if err != nil {
+		return err
+	}
+	taxable := b.Item
+	if tax.ShippingIncludedInTax {
+		if taxable, err = taxable.Add(shipping); err != nil {
+			return err
+		}
+	}
+	b.SalesTax = percentOf(taxable, tax.SalesTaxPercent)
+	total := b.Item
+	for _, m := range []Money{shipping, b.Insurance, b.Import, b.SalesTax} {
+		if total, err = total.Add(m); err != nil {
+			return err
+		}
+	}
+	b.Total = total
+	return nil
+}
+
+// percentOf returns percent of the amount rounded half away from zero to minor units.
+// The percent is taken with precision of 3 decimal places which is used by eBay tax tables.
+func percentOf(m Money, percent float64) Money {
+	rate := int64(math.Round(percent * 1000))
+	v := m.Amount * rate
+	half := int64(100000 / 2)
+	if v < 0 {
+		half = -half
+	}
+	return Money{Amount: (v + half) / 100000, Currency: m.Currency}
+}
@@ -0,0 +1,105 @@
+package shopping
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLandedCost(t *testing.T) {
+	usd := func(amount int64) Money { return NewMoney(amount, "USD") }
+	item := Item{ConvertedCurrentPrice: usd(1000), CurrentPrice: NewMoney(900, "EUR")}
+	shipping := GetShippingCostsResponse{
+		ShippingDetails: ShippingDetails{
+			InsuranceCost:   usd(150),
+			InsuranceOption: InsuranceOptionRequired,
+			SalesTax:        SalesTax{SalesTaxPercent: 8.875, ShippingIncludedInTax: true},
+			ShippingServiceOptions: []ShippingServiceOption{
+				{ShippingServiceName: "Expedited", ShippingServiceCost: usd(900), ShippingServiceAdditionalCost: usd(100)},
+				{ShippingServiceName: "Standard", ShippingServiceCost: usd(500), ShippingServiceAdditionalCost: usd(250)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		quantity int
+		want     LandedCostBreakdown
+	}{
+		{
+			name:     "single",
+			quantity: 1,
+			want: LandedCostBreakdown{
+				ShippingServiceName: "Standard",
+				Quantity:            1,
+				Item:                usd(1000),
+				Shipping:            usd(500),
+				Insurance:           usd(150),
+				// 8.875% of 15.00
+				SalesTax: usd(133),
+				Total:    usd(1783),
+			},
+		},
+		{
+			name:     "additional items make expedited cheaper",
+			quantity: 5,
+			want: LandedCostBreakdown{
+				ShippingServiceName: "Expedited",
+				Quantity:            5,
+				Item:                usd(5000),
+				Shipping:            usd(900),
+				AdditionalShipping:  usd(400),
+				Insurance:           usd(150),
+				// 8.875% of 63.00
+				SalesTax: usd(559),
+				Total:    usd(7009),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LandedCost(item, shipping, tt.quantity)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLandedCost_Summary(t *testing.T) {
+	shipping := GetShippingCostsResponse{
+		ShippingCostSummary: ShippingCostSummary{
+			ShippingServiceName: "International Priority",
+			ShippingServiceCost: NewMoney(2000, "GBP"),
+			ImportCharge:        NewMoney(350, "GBP"),
+			InsuranceCost:       NewMoney(100, "GBP"),
+			InsuranceOption:     InsuranceOptionOptional,
+		},
+	}
+	got, err := LandedCost(Item{ConvertedCurrentPrice: NewMoney(1000, "GBP")}, shipping, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, NewMoney(350, "GBP"), got.Import)
+		assert.True(t, got.Insurance.IsZero())
+		assert.Equal(t, NewMoney(3350, "GBP"), got.Total)
+	}
+}
+
+func TestLandedCost_Errors(t *testing.T) {
+	_, err := LandedCost(Item{}, GetShippingCostsResponse{}, 1)
+	assert.True(t, errors.Is(err, ErrNoShippingService))
+
+	_, err = LandedCost(Item{}, GetShippingCostsResponse{}, 0)
+	assert.Error(t, err)
+
+	shipping := GetShippingCostsResponse{
+		ShippingDetails: ShippingDetails{
+			ShippingServiceOptions: []ShippingServiceOption{
+				{ShippingServiceName: "Standard", ShippingServiceCost: NewMoney(500, "EUR")},
+			},
+		},
+	}
+	_, err = LandedCost(Item{ConvertedCurrentPrice: NewMoney(1000, "USD")}, shipping, 1)
+	var mismatch *CurrencyMismatchError
+	assert.True(t, errors.As(err, &mismatch), "got %v", err)
+}
@@ -0,0 +1,179 @@
+package shopping
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+)
+
+// Logger is a structured logger used by Service.WithLogger.
+// Arguments are alternating keys and values. *slog.Logger from log/slog satisfies this interface.
+type Logger interface {
+	InfoContext(ctx context.Context, msg string, args ...interface{})
+	WarnContext(ctx context.Context, msg string, args ...interface{})
+	ErrorContext(ctx context.Context, msg string, args ...interface{})
+}
+
+// Redacted replaces values of redacted headers and XML elements in logs
+const Redacted = "[REDACTED]"
+
+// DefaultRedactedHeaders are headers which are redacted by default
+var DefaultRedactedHeaders = []string{"X-EBAY-API-IAF-TOKEN", "Authorization"}
+
+// DefaultRedactedElements are elements of request and response bodies which are redacted by default.
+// They contain contact data of business sellers (see BusinessSellerDetails).
+var DefaultRedactedElements = []string{"Address", "Email", "Fax", "Phone"}
+
+// LogOptions configures logging of calls (see Service.WithLogger)
+type LogOptions struct {
+	// LogHeaders enables logging of request headers
+	LogHeaders bool
+	// LogBodies enables logging of request and response bodies
+	LogBodies bool
+	// RedactHeaders are headers whose values are replaced by Redacted. Nil means DefaultRedactedHeaders.
+	RedactHeaders []string
+	// RedactElements are XML elements (JSON fields in JSON encoding) whose contents are replaced by Redacted
+	// in logged bodies. Nil means DefaultRedactedElements.
+	RedactElements []string
+}
+
+// NewLoggingMiddleware creates Middleware which logs every call to eBay:
+// operation, site ID, attempt, duration, HTTP status, Ack, error codes and body sizes,
+// and optionally headers and bodies (see LogOptions).
+// Successful calls are logged with Info level, calls with Ack Warning with Warn level, failed calls with Error level.
+func NewLoggingMiddleware(logger Logger, options LogOptions) Middleware {
+	redactor := newRedactor(options)
+	return func(next CallHandler) CallHandler {
+		return func(ctx context.Context, call *Call) (*CallResult, error) {
+			result, err := next(ctx, call)
+
+			args := []interface{}{
+				"operation", string(call.Operation),
+				"site_id", call.SiteID,
+				"attempt", call.Attempt,
+				"request_size", len(call.Body),
+			}
+			if options.LogHeaders {
+				args = append(args, "headers", redactor.header(call.Header))
+			}
+			if options.LogBodies {
+				args = append(args, "request_body", redactor.body(call.Body))
+			}
+			if err != nil {
+				args = append(args, "error", err.Error())
+				logger.ErrorContext(ctx, "ebay call failed", args...)
+				return result, err
+			}
+			args = append(args,
+				"duration", result.Duration,
+				"status", result.StatusCode,
+				"ack", result.Ack,
+				"response_size", len(result.Body),
+			)
+			if len(result.Errors) > 0 {
+				codes := make([]string, 0, len(result.Errors))
+				for _, e := range result.Errors {
+					codes = append(codes, e.ErrorCode)
+				}
+				args = append(args, "error_codes", codes)
+			}
+			if options.LogBodies {
+				args = append(args, "response_body", redactor.body(result.Body))
+			}
+			switch {
+			case result.StatusCode != http.StatusOK || result.Ack == AckFailure || result.Ack == AckPartialFailure:
+				logger.ErrorContext(ctx, "ebay call failed", args...)
+			case result.Ack == AckWarning:
+				logger.WarnContext(ctx, "ebay call", args...)
+			default:
+				logger.InfoContext(ctx, "ebay call", args...)
+			}
+			return result, nil
+		}
+	}
+}
+
+// redactor redacts headers and bodies for logging
+type redactor struct {
+	headers  []string
+	elements map[string]bool
+	// xml contains a regexp per redacted element
+	xml []*regexp.Regexp
+}
+
+func newRedactor(options LogOptions) *redactor {
+	r := &redactor{headers: options.RedactHeaders, elements: make(map[string]bool)}
+	if r.headers == nil {
+		r.headers = DefaultRedactedHeaders
+	}
+	elements := options.RedactElements
+	if elements == nil {
+		elements = DefaultRedactedElements
+	}
+	for _, e := range elements {
+		r.elements[e] = true
+		// element with optional namespace prefix and attributes
+		name := regexp.QuoteMeta(e)
+		r.xml = append(r.xml, regexp.MustCompile(`(?s)(<(?:\w+:)?`+name+`(?:\s[^>]*)?>).*?(</(?:\w+:)?`+name+`>)`))
+	}
+	return r
+}
+
+// header returns a copy of h with redacted values
+func (r *redactor) header(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range r.headers {
+		if h.Get(name) != "" {
+			h.Set(name, Redacted)
+		}
+	}
+	return h
+}
+
+// body returns body with redacted elements. Bodies which are neither XML nor JSON are returned as is.
+func (r *redactor) body(body []byte) string {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || len(r.elements) == 0 {
+		return string(body)
+	}
+	switch trimmed[0] {
+	case '<':
+		s := string(body)
+		for _, re := range r.xml {
+			s = re.ReplaceAllString(s, "${1}"+Redacted+"${2}")
+		}
+		return s
+	case '{':
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return string(body)
+		}
+		b, err := json.Marshal(r.redactJSON(v))
+		if err != nil {
+			return string(body)
+		}
+		return string(b)
+	}
+	return string(body)
+}
+
+// redactJSON replaces values of redacted fields
+func (r *redactor) redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, fv := range v {
+			if r.elements[k] {
+				v[k] = Redacted
+				continue
+			}
+			v[k] = r.redactJSON(fv)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = r.redactJSON(v[i])
+		}
+	}
+	return v
+}
@@ -0,0 +1,87 @@
+package shopping
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testLogger records logged messages
+type testLogger struct {
+	records []testLogRecord
+}
+
+type testLogRecord struct {
+	level string
+	msg   string
+	attrs map[string]interface{}
+}
+
+func (l *testLogger) log(level, msg string, args []interface{}) {
+	attrs := make(map[string]interface{})
+	for i := 0; i+1 < len(args); i += 2 {
+		attrs[fmt.Sprint(args[i])] = args[i+1]
+	}
+	l.records = append(l.records, testLogRecord{level: level, msg: msg, attrs: attrs})
+}
+
+func (l *testLogger) InfoContext(_ context.Context, msg string, args ...interface{}) {
+	l.log("INFO", msg, args)
+}
+
+func (l *testLogger) WarnContext(_ context.Context, msg string, args ...interface{}) {
+	l.log("WARN", msg, args)
+}
+
+func (l *testLogger) ErrorContext(_ context.Context, msg string, args ...interface{}) {
+	l.log("ERROR", msg, args)
+}
+
+func TestService_WithLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<GetSingleItemResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>Warning</Ack>` +
+			`<Errors><ErrorCode>10.1</ErrorCode><SeverityCode>Warning</SeverityCode></Errors>` +
+			`<Item><ItemID>1</ItemID><BusinessSellerDetails><Address><Street1>Main St. 1</Street1>` +
+			`<Phone>+1 555</Phone></Address><Email>seller@example.com</Email></BusinessSellerDetails></Item>` +
+			`</GetSingleItemResponse>`))
+	}))
+	defer server.Close()
+
+	logger := &testLogger{}
+	service := NewService("secret-token").WithEndpoint(server.URL).
+		WithLogger(logger, LogOptions{LogHeaders: true, LogBodies: true})
+	res, err := service.NewGetSingleItemRequest().WithItemID("1").ExecuteContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "seller@example.com", res.Item.BusinessSellerDetails.Email)
+
+	if !assert.Len(t, logger.records, 1) {
+		return
+	}
+	rec := logger.records[0]
+	assert.Equal(t, "WARN", rec.level)
+	assert.Equal(t, string(OperationGetSingleItem), rec.attrs["operation"])
+	assert.Equal(t, http.StatusOK, rec.attrs["status"])
+	assert.Equal(t, AckWarning, rec.attrs["ack"])
+	assert.Equal(t, []string{"10.1"}, rec.attrs["error_codes"])
+	assert.Equal(t, Redacted, rec.attrs["headers"].(http.Header).Get("X-EBAY-API-IAF-TOKEN"))
+
+	body := rec.attrs["response_body"].(string)
+	assert.Contains(t, body, "<ItemID>1</ItemID>")
+	assert.Contains(t, body, "<Address>"+Redacted+"</Address>")
+	assert.Contains(t, body, "<Email>"+Redacted+"</Email>")
+	assert.NotContains(t, body, "seller@example.com")
+	assert.NotContains(t, body, "555")
+}
+
+func TestRedactor_Body(t *testing.T) {
+	r := newRedactor(LogOptions{RedactElements: []string{"Email", "Phone"}})
+	assert.Equal(t, `{"Item":{"Email":"[REDACTED]","ItemID":"1","Phones":[{"Phone":"[REDACTED]"}]}}`,
+		r.body([]byte(`{"Item":{"ItemID":"1","Email":"a@b.c","Phones":[{"Phone":"555"}]}}`)))
+	assert.Equal(t, `<ns:Email type="x">[REDACTED]</ns:Email><EmailX>a</EmailX>`,
+		r.body([]byte(`<ns:Email type="x">a@b.c</ns:Email><EmailX>a</EmailX>`)))
+	assert.Equal(t, "callname=GetSingleItem", r.body([]byte("callname=GetSingleItem")))
+}
@@ -0,0 +1,172 @@
+package shopping
+
+import (
+	"sync"
+	"time"
+)
+
+// Metrics receives metrics of calls made by Service (see Service.WithMetrics).
+// Implementations must be safe for concurrent use.
+// See MemoryMetrics and package prommetrics for Prometheus.
+type Metrics interface {
+	// ObserveCall is called after every attempt to call eBay
+	ObserveCall(call CallMetrics)
+	// ObserveCache is called after every lookup in the service cache
+	ObserveCache(operation EbayOperation, hit bool)
+	// ObserveRetry is called before every retry
+	ObserveRetry(operation EbayOperation)
+	// ObserveRateLimitWait is called after waiting for the service rate limiters (if the service has any)
+	ObserveRateLimitWait(operation EbayOperation, wait time.Duration)
+}
+
+// CallMetrics describes one attempt to call eBay
+type CallMetrics struct {
+	Operation EbayOperation
+	SiteID    string
+	// StatusCode is HTTP status code of the response. It is 0 if the response was not received.
+	StatusCode int
+	Ack        string
+	// ErrorCodes are eBay error codes of the response
+	ErrorCodes []string
+	Duration   time.Duration
+}
+
+// noopMetrics is used when the service has no metrics
+type noopMetrics struct{}
+
+func (noopMetrics) ObserveCall(CallMetrics)                           {}
+func (noopMetrics) ObserveCache(EbayOperation, bool)                  {}
+func (noopMetrics) ObserveRetry(EbayOperation)                        {}
+func (noopMetrics) ObserveRateLimitWait(EbayOperation, time.Duration) {}
+
+/*
+==============================================================
+*/
+
+// CallLabels are labels of calls counted by MemoryMetrics
+type CallLabels struct {
+	Operation  EbayOperation
+	SiteID     string
+	StatusCode int
+	Ack        string
+}
+
+// ErrorCodeLabels are labels of eBay errors counted by MemoryMetrics
+type ErrorCodeLabels struct {
+	Operation EbayOperation
+	SiteID    string
+	ErrorCode string
+}
+
+// MetricsSnapshot contains values of MemoryMetrics
+type MetricsSnapshot struct {
+	Calls map[CallLabels]int64
+	// CallDurations are total durations of calls per operation
+	CallDurations map[EbayOperation]time.Duration
+	Errors        map[ErrorCodeLabels]int64
+	CacheHits     map[EbayOperation]int64
+	CacheMisses   map[EbayOperation]int64
+	Retries       map[EbayOperation]int64
+	// RateLimitWaits are numbers of calls which passed rate limiters
+	RateLimitWaits map[EbayOperation]int64
+	// RateLimitWaitDurations are total durations of waiting for rate limiters
+	RateLimitWaitDurations map[EbayOperation]time.Duration
+}
+
+func newMetricsSnapshot() MetricsSnapshot {
+	return MetricsSnapshot{
+		Calls:                  make(map[CallLabels]int64),
+		CallDurations:          make(map[EbayOperation]time.Duration),
+		Errors:                 make(map[ErrorCodeLabels]int64),
+		CacheHits:              make(map[EbayOperation]int64),
+		CacheMisses:            make(map[EbayOperation]int64),
+		Retries:                make(map[EbayOperation]int64),
+		RateLimitWaits:         make(map[EbayOperation]int64),
+		RateLimitWaitDurations: make(map[EbayOperation]time.Duration),
+	}
+}
+
+// MemoryMetrics is Metrics which keeps counters in memory. It is useful for tests.
+type MemoryMetrics struct {
+	mu     sync.Mutex
+	values MetricsSnapshot
+}
+
+// NewMemoryMetrics creates new MemoryMetrics
+func NewMemoryMetrics() *MemoryMetrics {
+	return &MemoryMetrics{values: newMetricsSnapshot()}
+}
+
+// ObserveCall implements Metrics
+func (m *MemoryMetrics) ObserveCall(call CallMetrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values.Calls[CallLabels{
+		Operation:  call.Operation,
+		SiteID:     call.SiteID,
+		StatusCode: call.StatusCode,
+		Ack:        call.Ack,
+	}]++
+	m.values.CallDurations[call.Operation] += call.Duration
+	for _, code := range call.ErrorCodes {
+		m.values.Errors[ErrorCodeLabels{Operation: call.Operation, SiteID: call.SiteID, ErrorCode: code}]++
+	}
+}
+
+// ObserveCache implements Metrics
+func (m *MemoryMetrics) ObserveCache(operation EbayOperation, hit bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if hit {
+		m.values.CacheHits[operation]++
+	} else {
+		m.values.CacheMisses[operation]++
+	}
+}
+
+// ObserveRetry implements Metrics
+func (m *MemoryMetrics) ObserveRetry(operation EbayOperation) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values.Retries[operation]++
+}
+
+// ObserveRateLimitWait implements Metrics
+func (m *MemoryMetrics) ObserveRateLimitWait(operation EbayOperation, wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values.RateLimitWaits[operation]++
+	m.values.RateLimitWaitDurations[operation] += wait
+}
+
+// Snapshot returns copy of the current values
+func (m *MemoryMetrics) Snapshot() MetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := newMetricsSnapshot()
+	for k, v := range m.values.Calls {
+		s.Calls[k] = v
+	}
+	for k, v := range m.values.CallDurations {
+		s.CallDurations[k] = v
+	}
+	for k, v := range m.values.Errors {
+		s.Errors[k] = v
+	}
+	for k, v := range m.values.CacheHits {
+		s.CacheHits[k] = v
+	}
+	for k, v := range m.values.CacheMisses {
+		s.CacheMisses[k] = v
+	}
+	for k, v := range m.values.Retries {
+		s.Retries[k] = v
+	}
+	for k, v := range m.values.RateLimitWaits {
+		s.RateLimitWaits[k] = v
+	}
+	for k, v := range m.values.RateLimitWaitDurations {
+		s.RateLimitWaitDurations[k] = v
+	}
+	return s
+}
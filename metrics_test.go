@@ -0,0 +1,54 @@
+package shopping
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_WithMetrics(t *testing.T) {
+	var calls int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt64(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`<GetSingleItemResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>Warning</Ack>` +
+			`<Errors><ErrorCode>10.1</ErrorCode><SeverityCode>Warning</SeverityCode></Errors>` +
+			`<Item><ItemID>1</ItemID></Item></GetSingleItemResponse>`))
+	}))
+	defer server.Close()
+
+	metrics := NewMemoryMetrics()
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	service := NewService("").WithEndpoint(server.URL).
+		WithMetrics(metrics).
+		WithRetryPolicy(policy).
+		WithRateLimit(1000, 10).
+		WithCache(NewLRUCache(10))
+
+	for i := 0; i < 2; i++ {
+		_, err := service.NewGetSingleItemRequest().WithItemID("1").ExecuteContext(context.Background())
+		assert.NoError(t, err)
+	}
+
+	s := metrics.Snapshot()
+	op := OperationGetSingleItem
+	site := string(SiteIDEbayUS)
+	assert.Equal(t, map[CallLabels]int64{
+		{Operation: op, SiteID: site, StatusCode: http.StatusServiceUnavailable}:  1,
+		{Operation: op, SiteID: site, StatusCode: http.StatusOK, Ack: AckWarning}: 1,
+	}, s.Calls)
+	assert.Equal(t, map[ErrorCodeLabels]int64{{Operation: op, SiteID: site, ErrorCode: "10.1"}: 1}, s.Errors)
+	assert.True(t, s.CallDurations[op] > 0)
+	assert.Equal(t, int64(1), s.Retries[op])
+	assert.Equal(t, int64(1), s.CacheHits[op])
+	assert.Equal(t, int64(1), s.CacheMisses[op])
+	assert.Equal(t, int64(2), s.RateLimitWaits[op])
+}
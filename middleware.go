@@ -0,0 +1,115 @@
+package shopping
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Call is a call to eBay passed through the service middlewares (see Service.WithMiddleware).
+// Middlewares can change it before calling the next handler.
+type Call struct {
+	Operation EbayOperation
+	SiteID    string
+	// Method is POST, or GET in name-value mode
+	Method string
+	// URL is the service endpoint
+	URL string
+	// Header contains all headers of the call including IAF token
+	Header http.Header
+	// Body is the serialized request. In name-value mode it is URL query which is appended to URL.
+	Body []byte
+	// Attempt is a number of the attempt (starting from 1) according to the service RetryPolicy
+	Attempt int
+}
+
+// CallResult is a response of eBay passed back through the service middlewares
+type CallResult struct {
+	// Response is a raw response with already read body.
+	// It is nil if the result was created by a middleware.
+	Response   *http.Response
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	// Ack, CorrelationID and Errors are decoded from Body (only these fields, the rest is decoded once
+	// into the response after the middlewares)
+	Ack           string
+	CorrelationID string
+	Errors        []Error
+	// Duration is the time spent sending the call and receiving the response
+	Duration time.Duration
+}
+
+// CallHandler sends the call to eBay. Error is returned only if the response was not received,
+// otherwise the result must not be nil.
+type CallHandler func(ctx context.Context, call *Call) (*CallResult, error)
+
+// Middleware wraps CallHandler. It can change the call and the result, or return a result (or an error)
+// without calling next, e.g. for fault injection.
+// Middlewares are called for every attempt, but not for responses taken from the service cache.
+type Middleware func(next CallHandler) CallHandler
+
+// handler returns the handler of the transport wrapped in the service middlewares
+func (s *Service) handler() CallHandler {
+	h := s.roundTrip
+	for i := len(s.middlewares) - 1; i >= 0; i-- {
+		h = s.middlewares[i](h)
+	}
+	return h
+}
+
+// nvURL adds name-value query to the endpoint URL keeping its own query parameters
+func nvURL(endpoint string, query []byte) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	values, err := url.ParseQuery(string(query))
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	for k, v := range values {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// roundTrip sends the call using the service HTTP client
+func (s *Service) roundTrip(ctx context.Context, call *Call) (*CallResult, error) {
+	req := s.client.R().SetContext(ctx)
+	req.Header = call.Header.Clone()
+	start := time.Now()
+	var res *resty.Response
+	var err error
+	if call.Method == http.MethodGet {
+		u, uErr := nvURL(call.URL, call.Body)
+		if uErr != nil {
+			return nil, uErr
+		}
+		res, err = req.Get(u)
+	} else {
+		res, err = req.SetBody(call.Body).Post(call.URL)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := &CallResult{
+		Response:   res.RawResponse,
+		StatusCode: res.StatusCode(),
+		Header:     res.Header(),
+		Body:       res.Body(),
+		Duration:   time.Since(start),
+	}
+	rs := responseStandard{}
+	if s.unmarshalEnvelope(result.Body, &rs) == nil {
+		result.Ack = rs.Ack
+		result.CorrelationID = rs.CorrelationID
+		result.Errors = rs.Errors
+	}
+	return result, nil
+}
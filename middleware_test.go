@@ -0,0 +1,100 @@
+package shopping
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_WithMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "signature", r.Header.Get("X-Signature"))
+		assert.Equal(t, "token", r.Header.Get("X-EBAY-API-IAF-TOKEN"))
+		_, _ = w.Write([]byte(testTimeResponse))
+	}))
+	defer server.Close()
+
+	var order []string
+	var result *CallResult
+	service := NewService("token").WithEndpoint(server.URL).WithMiddleware(
+		func(next CallHandler) CallHandler {
+			return func(ctx context.Context, call *Call) (*CallResult, error) {
+				order = append(order, "outer")
+				res, err := next(ctx, call)
+				result = res
+				return res, err
+			}
+		},
+		func(next CallHandler) CallHandler {
+			return func(ctx context.Context, call *Call) (*CallResult, error) {
+				order = append(order, "inner")
+				assert.Equal(t, OperationGeteBayTime, call.Operation)
+				assert.Equal(t, http.MethodPost, call.Method)
+				assert.Contains(t, string(call.Body), "<GeteBayTimeRequest")
+				call.Header.Set("X-Signature", "signature")
+				return next(ctx, call)
+			}
+		},
+	)
+
+	_, err := service.NewGeteBayTimeRequest().ExecuteContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"outer", "inner"}, order)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, http.StatusOK, result.StatusCode)
+		assert.Equal(t, AckSuccess, result.Ack)
+		assert.NotNil(t, result.Response)
+		assert.True(t, result.Duration > 0)
+	}
+}
+
+func TestService_WithMiddlewareShortCircuit(t *testing.T) {
+	var calls int64
+	service := NewService("").
+		WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, &calls)).
+		WithRetryPolicy(DefaultRetryPolicy()).
+		WithMiddleware(func(next CallHandler) CallHandler {
+			return func(ctx context.Context, call *Call) (*CallResult, error) {
+				// fault injection: the first attempt fails
+				if call.Attempt == 1 {
+					return &CallResult{StatusCode: http.StatusServiceUnavailable}, nil
+				}
+				return next(ctx, call)
+			}
+		})
+	service.retryPolicy.BaseBackoff = 0
+
+	res, err := service.NewGeteBayTimeRequest().ExecuteContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, AckSuccess, res.Ack)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+
+	injected := errors.New("injected")
+	service = NewService("").
+		WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, &calls)).
+		WithMiddleware(func(next CallHandler) CallHandler {
+			return func(ctx context.Context, call *Call) (*CallResult, error) {
+				return nil, injected
+			}
+		})
+	_, err = service.NewGeteBayTimeRequest().ExecuteContext(context.Background())
+	assert.True(t, errors.Is(err, injected), "got %v", err)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+}
+
+func TestService_WithMiddlewareNilResult(t *testing.T) {
+	service := NewService("").
+		WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, nil)).
+		WithMiddleware(func(next CallHandler) CallHandler {
+			return func(ctx context.Context, call *Call) (*CallResult, error) {
+				return nil, nil
+			}
+		})
+	_, err := service.NewGeteBayTimeRequest().ExecuteContext(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,363 @@
+package shopping
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// currencyFormat describes how amounts of a currency are written
+type currencyFormat struct {
+	// digits is the number of minor unit digits (ISO 4217 exponent)
+	digits int
+	symbol string
+}
+
+// currencyFormats contains currencies which differ from the default format
+// (2 minor unit digits and "CODE " prefix) or have a well-known symbol.
+// See more: https://developer.ebay.com/Devzone/shopping/docs/CallRef/types/CurrencyCodeType.html
+var currencyFormats = map[string]currencyFormat{
+	"AUD": {digits: 2, symbol: "AU $"},
+	"CAD": {digits: 2, symbol: "C $"},
+	"CHF": {digits: 2, symbol: "CHF "},
+	"CNY": {digits: 2, symbol: "CN¥"},
+	"EUR": {digits: 2, symbol: "€"},
+	"GBP": {digits: 2, symbol: "£"},
+	"HKD": {digits: 2, symbol: "HK $"},
+	"INR": {digits: 2, symbol: "₹"},
+	"JPY": {digits: 0, symbol: "¥"},
+	"KRW": {digits: 0, symbol: "₩"},
+	"PHP": {digits: 2, symbol: "₱"},
+	"PLN": {digits: 2, symbol: "PLN "},
+	"SGD": {digits: 2, symbol: "S $"},
+	"TWD": {digits: 2, symbol: "NT $"},
+	"USD": {digits: 2, symbol: "$"},
+}
+
+// currencyDigits returns the number of minor unit digits of the currency
+func currencyDigits(currency string) int {
+	if f, ok := currencyFormats[currency]; ok {
+		return f.digits
+	}
+	return 2
+}
+
+// ErrMoneyOverflow is returned by Money operations when the result does not fit into int64 minor units
+var ErrMoneyOverflow = errors.New("money amount overflows int64")
+
+// CurrencyMismatchError is returned by Money operations when amounts have different currencies
+type CurrencyMismatchError struct {
+	Left  string
+	Right string
+}
+
+// Error implements error interface
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("currency mismatch: %s and %s", e.Left, e.Right)
+}
+
+// Money is an exact monetary amount. Amount is stored in minor units of Currency (cents for USD, yen for JPY).
+// It is decoded from eBay AmountType elements with currencyID attribute.
+// The zero value has no currency and can be added to an amount of any currency.
+type Money struct {
+	// Amount in minor units
+	Amount   int64
+	Currency string
+	// Raw is the original value returned by eBay if it could not be represented exactly:
+	// it had more fractional digits than Currency allows and Amount was rounded half away from zero,
+	// or it could not be parsed at all and Amount is 0. Raw is empty for exact amounts, see Exact.
+	Raw string
+}
+
+// Price is an amount returned by eBay. It is an alias of Money.
+type Price = Money
+
+// NewMoney creates Money from amount in minor units
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseMoney parses decimal amount like "12.34" of the given currency.
+// It returns an error if the amount has more fractional digits than the currency allows
+// (except trailing zeros). Unknown currencies are assumed to have 2 fractional digits.
+func ParseMoney(amount, currency string) (Money, error) {
+	return parseMoney(amount, currency, false)
+}
+
+// decodeMoney parses amount returned by eBay. It never fails, so one unusual amount does not break
+// decoding of the whole response: extra fractional digits are rounded half away from zero
+// and invalid amounts are decoded as 0. In both cases the original value is kept in Raw.
+func decodeMoney(amount, currency string) Money {
+	m, err := parseMoney(amount, currency, true)
+	if err != nil {
+		return Money{Currency: currency, Raw: amount}
+	}
+	return m
+}
+
+// parseMoney parses decimal amount. If round is true, extra fractional digits are rounded
+// half away from zero and the original amount is kept in Raw, otherwise an error is returned.
+func parseMoney(amount, currency string, round bool) (Money, error) {
+	s := strings.TrimSpace(amount)
+	if s == "" {
+		return Money{Currency: currency}, nil
+	}
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return Money{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	digits := currencyDigits(currency)
+	raw := ""
+	roundUp := false
+	if len(fracPart) > digits {
+		if strings.Trim(fracPart[digits:], "0") != "" {
+			if !round {
+				return Money{}, fmt.Errorf("amount %q has more than %d fractional digits for currency %q", amount, digits, currency)
+			}
+			raw = amount
+			roundUp = fracPart[digits] >= '5'
+		}
+		fracPart = fracPart[:digits]
+	}
+	fracPart += strings.Repeat("0", digits-len(fracPart))
+	if intPart == "" {
+		intPart = "0"
+	}
+	v, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+	if roundUp {
+		if v == math.MaxInt64 {
+			return Money{}, fmt.Errorf("invalid amount %q: %w", amount, ErrMoneyOverflow)
+		}
+		v++
+	}
+	if negative {
+		v = -v
+	}
+	return Money{Amount: v, Currency: currency, Raw: raw}, nil
+}
+
+// isDigits checks that s consists of ASCII digits only
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Exact checks if the amount was returned by eBay exactly (Raw is empty)
+func (m Money) Exact() bool {
+	return m.Raw == ""
+}
+
+// IsZero checks if the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// currencyOf returns common currency of m and o.
+// A zero amount without currency takes the currency of the other amount.
+func (m Money) currencyOf(o Money) (string, error) {
+	switch {
+	case m.Currency == o.Currency:
+		return m.Currency, nil
+	case m.Currency == "" && m.Amount == 0:
+		return o.Currency, nil
+	case o.Currency == "" && o.Amount == 0:
+		return m.Currency, nil
+	}
+	return "", &CurrencyMismatchError{Left: m.Currency, Right: o.Currency}
+}
+
+// Add returns the sum of m and o. It returns CurrencyMismatchError if currencies differ
+// and ErrMoneyOverflow if the sum does not fit into int64.
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := m.currencyOf(o)
+	if err != nil {
+		return Money{}, err
+	}
+	sum := m.Amount + o.Amount
+	if (sum > m.Amount) != (o.Amount > 0) {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: sum, Currency: currency}, nil
+}
+
+// Sub returns the difference of m and o. It returns CurrencyMismatchError if currencies differ
+// and ErrMoneyOverflow if the difference does not fit into int64.
+func (m Money) Sub(o Money) (Money, error) {
+	currency, err := m.currencyOf(o)
+	if err != nil {
+		return Money{}, err
+	}
+	diff := m.Amount - o.Amount
+	if (diff < m.Amount) != (o.Amount > 0) {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: diff, Currency: currency}, nil
+}
+
+// Mul returns the amount multiplied by quantity. It returns ErrMoneyOverflow if the result does not fit into int64.
+func (m Money) Mul(quantity int) (Money, error) {
+	q := int64(quantity)
+	v := m.Amount * q
+	if q != 0 && (v/q != m.Amount || q == -1 && m.Amount == math.MinInt64) {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: v, Currency: m.Currency}, nil
+}
+
+// Cmp compares m and o. It returns -1 if m < o, 0 if m == o and +1 if m > o.
+// It returns CurrencyMismatchError if currencies differ.
+func (m Money) Cmp(o Money) (int, error) {
+	if _, err := m.currencyOf(o); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Decimal returns the amount as decimal string with currency minor unit digits, e.g. "12.30"
+func (m Money) Decimal() string {
+	digits := currencyDigits(m.Currency)
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	s := strconv.FormatInt(amount, 10)
+	if digits == 0 {
+		return sign + s
+	}
+	if len(s) <= digits {
+		s = strings.Repeat("0", digits-len(s)+1) + s
+	}
+	return sign + s[:len(s)-digits] + "." + s[len(s)-digits:]
+}
+
+// Float64 returns the amount in major units. It is inexact and should be used only for display or statistics.
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.Decimal(), 64)
+	return f
+}
+
+// Format returns the amount with currency symbol, e.g. "$12.30", "¥1500" or "12.30 XYZ" for unknown currencies
+func (m Money) Format() string {
+	if f, ok := currencyFormats[m.Currency]; ok {
+		if m.Amount < 0 {
+			return "-" + f.symbol + m.Decimal()[1:]
+		}
+		return f.symbol + m.Decimal()
+	}
+	return m.String()
+}
+
+// String returns the amount with currency code, e.g. "12.30 USD"
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Decimal()
+	}
+	return m.Decimal() + " " + m.Currency
+}
+
+// UnmarshalXML decodes AmountType element with currencyID attribute.
+// Amounts which cannot be represented exactly do not fail decoding, see Raw.
+func (m *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	currency := ""
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "currencyID" {
+			currency = attr.Value
+		}
+	}
+	*m = decodeMoney(s, currency)
+	return nil
+}
+
+// UnmarshalJSON decodes AmountType object {"Value": 12.34, "CurrencyID": "USD"} or a bare amount.
+// The amount may be a number or a string. Like UnmarshalXML, it does not fail on inexact amounts.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		Value      json.RawMessage
+		CurrencyID string
+	}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(trimmed, &obj); err != nil {
+			return err
+		}
+	} else {
+		obj.Value = trimmed
+	}
+	s, ok, err := jsonText(obj.Value)
+	if err != nil {
+		return fmt.Errorf("amount: %w", err)
+	}
+	if !ok && obj.CurrencyID == "" {
+		return nil
+	}
+	*m = decodeMoney(s, obj.CurrencyID)
+	return nil
+}
+
+// MarshalJSON encodes Money as AmountType object {"Value": 12.34, "CurrencyID": "USD"}.
+// Inexact amounts are encoded as the original value (Raw) in a string.
+func (m Money) MarshalJSON() ([]byte, error) {
+	obj := struct {
+		Value      json.RawMessage
+		CurrencyID string `json:",omitempty"`
+	}{Value: json.RawMessage(m.Decimal()), CurrencyID: m.Currency}
+	if !m.Exact() {
+		raw, err := json.Marshal(m.Raw)
+		if err != nil {
+			return nil, err
+		}
+		obj.Value = raw
+	}
+	return json.Marshal(obj)
+}
+
+// MarshalXML encodes Money as AmountType element with currencyID attribute.
+// Inexact amounts are encoded as the original value (Raw).
+func (m Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if m.Currency != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "currencyID"}, Value: m.Currency})
+	}
+	return e.EncodeElement(m.text(), start)
+}
+
+// text returns the amount as it is encoded: Raw for inexact amounts, Decimal otherwise
+func (m Money) text() string {
+	if !m.Exact() {
+		return m.Raw
+	}
+	return m.Decimal()
+}
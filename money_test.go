@@ -0,0 +1,157 @@
+package shopping
+
+import (
+	"encoding/xml"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		want     Money
+		wantErr  bool
+	}{
+		{amount: "12.34", currency: "USD", want: Money{Amount: 1234, Currency: "USD"}},
+		{amount: "0.1", currency: "USD", want: Money{Amount: 10, Currency: "USD"}},
+		{amount: "7", currency: "EUR", want: Money{Amount: 700, Currency: "EUR"}},
+		{amount: "1500.0", currency: "JPY", want: Money{Amount: 1500, Currency: "JPY"}},
+		{amount: "-3.50", currency: "GBP", want: Money{Amount: -350, Currency: "GBP"}},
+		{amount: ".99", currency: "", want: Money{Amount: 99}},
+		{amount: "", currency: "USD", want: Money{Currency: "USD"}},
+		{amount: "1.005", currency: "USD", wantErr: true},
+		{amount: "1e3", currency: "USD", wantErr: true},
+		{amount: ".", currency: "USD", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.amount+tt.currency, func(t *testing.T) {
+			got, err := ParseMoney(tt.amount, tt.currency)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	usd := func(amount int64) Money { return NewMoney(amount, "USD") }
+
+	sum, err := usd(1010).Add(usd(2020))
+	if assert.NoError(t, err) {
+		assert.Equal(t, usd(3030), sum)
+	}
+	sum, err = Money{}.Add(usd(5))
+	if assert.NoError(t, err) {
+		assert.Equal(t, usd(5), sum)
+	}
+	diff, err := usd(100).Sub(usd(250))
+	if assert.NoError(t, err) {
+		assert.Equal(t, usd(-150), diff)
+	}
+	product, err := usd(1110).Mul(3)
+	if assert.NoError(t, err) {
+		assert.Equal(t, usd(3330), product)
+	}
+
+	cmp, err := usd(100).Cmp(usd(99))
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, cmp)
+	}
+
+	_, err = usd(100).Add(NewMoney(100, "EUR"))
+	var mismatch *CurrencyMismatchError
+	if assert.True(t, errors.As(err, &mismatch)) {
+		assert.Equal(t, CurrencyMismatchError{Left: "USD", Right: "EUR"}, *mismatch)
+	}
+	_, err = usd(100).Cmp(NewMoney(100, "EUR"))
+	assert.Error(t, err)
+}
+
+func TestMoney_Overflow(t *testing.T) {
+	usd := func(amount int64) Money { return NewMoney(amount, "USD") }
+
+	_, err := usd(math.MaxInt64).Add(usd(1))
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	_, err = usd(math.MinInt64).Add(usd(-1))
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	_, err = usd(math.MinInt64).Sub(usd(1))
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	_, err = usd(-1).Sub(usd(math.MaxInt64))
+	assert.NoError(t, err)
+	_, err = usd(math.MaxInt64 / 2).Mul(3)
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	_, err = usd(math.MinInt64).Mul(-1)
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	product, err := usd(math.MaxInt64).Mul(0)
+	if assert.NoError(t, err) {
+		assert.Equal(t, usd(0), product)
+	}
+
+	_, err = ParseMoney("92233720368547758.07", "USD")
+	assert.NoError(t, err)
+	m := decodeMoney("92233720368547758.075", "USD")
+	assert.Equal(t, Money{Currency: "USD", Raw: "92233720368547758.075"}, m)
+}
+
+func TestMoney_Format(t *testing.T) {
+	tests := []struct {
+		money      Money
+		wantString string
+		wantFormat string
+	}{
+		{money: NewMoney(1230, "USD"), wantString: "12.30 USD", wantFormat: "$12.30"},
+		{money: NewMoney(5, "EUR"), wantString: "0.05 EUR", wantFormat: "€0.05"},
+		{money: NewMoney(1500, "JPY"), wantString: "1500 JPY", wantFormat: "¥1500"},
+		{money: NewMoney(-199, "GBP"), wantString: "-1.99 GBP", wantFormat: "-£1.99"},
+		{money: NewMoney(100, "XYZ"), wantString: "1.00 XYZ", wantFormat: "1.00 XYZ"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantString, func(t *testing.T) {
+			assert.Equal(t, tt.wantString, tt.money.String())
+			assert.Equal(t, tt.wantFormat, tt.money.Format())
+		})
+	}
+}
+
+func TestMoney_XML(t *testing.T) {
+	var item Item
+	err := xml.Unmarshal([]byte(`<Item><CurrentPrice currencyID="USD">19.99</CurrentPrice>`+
+		`<ShippingCostSummary><ShippingServiceCost currencyID="USD">0.1</ShippingServiceCost></ShippingCostSummary></Item>`), &item)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, NewMoney(1999, "USD"), item.CurrentPrice)
+	assert.Equal(t, NewMoney(10, "USD"), item.ShippingCostSummary.ShippingServiceCost)
+
+	b, err := xml.Marshal(item.CurrentPrice)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `<Money currencyID="USD">19.99</Money>`, string(b))
+	}
+
+	// unusual amounts do not fail the whole response
+	item = Item{}
+	err = xml.Unmarshal([]byte(`<Item><CurrentPrice currencyID="USD">abc</CurrentPrice>`+
+		`<ConvertedCurrentPrice currencyID="USD">10.005</ConvertedCurrentPrice>`+
+		`<ShippingCostSummary><ShippingServiceCost currencyID="JPY">-12.4</ShippingServiceCost></ShippingCostSummary></Item>`), &item)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, Money{Currency: "USD", Raw: "abc"}, item.CurrentPrice)
+	assert.False(t, item.CurrentPrice.Exact())
+	assert.Equal(t, Money{Amount: 1001, Currency: "USD", Raw: "10.005"}, item.ConvertedCurrentPrice)
+	assert.Equal(t, Money{Amount: -12, Currency: "JPY", Raw: "-12.4"}, item.ShippingCostSummary.ShippingServiceCost)
+
+	// the original value of inexact amounts is kept
+	b, err = xml.Marshal(item.ConvertedCurrentPrice)
+	if assert.NoError(t, err) {
+		assert.Equal(t, `<Money currencyID="USD">10.005</Money>`, string(b))
+	}
+}
@@ -0,0 +1,139 @@
+package shopping
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Name-value (NV) encoding renders a request into URL parameters:
+//   - nested elements are joined with dots (VariationSpecifics.NameValueList(0).Name);
+//   - repeated values are joined with commas (ItemID=1,2,3), as in the samples of eBay call reference
+//     (see https://developer.ebay.com/Devzone/shopping/docs/CallRef/GetMultipleItems.html);
+//   - repeated containers get zero-based index in parentheses (NameValueList(0).Name, NameValueList(1).Name);
+//   - attributes are named after the element (ProductID.type), element text is ProductID.Value.
+//
+// Like JSON encoding, it is driven by xml tags of the request types.
+
+// marshalNV encodes request v into URL parameters
+func marshalNV(v interface{}) (url.Values, error) {
+	values := url.Values{}
+	if err := addNVValues(values, "", reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// addNVValues adds v to values using key as a prefix
+func addNVValues(values url.Values, key string, v reflect.Value) error {
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		return addNVValues(values, key, v.Elem())
+	}
+	if v.Type().Implements(xmlMarshalerType) {
+		j, err := xmlMarshalerToJSON(v.Interface().(xml.Marshaler))
+		if err != nil {
+			return err
+		}
+		if obj, ok := j.(map[string]interface{}); ok {
+			for k, value := range obj {
+				values.Set(nvKey(key, k), fmt.Sprint(value))
+			}
+			return nil
+		}
+		values.Set(key, fmt.Sprint(j))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		for _, f := range xmlFields(v.Type()) {
+			fv := v.FieldByIndex(f.index)
+			if f.omitempty && fv.IsZero() {
+				continue
+			}
+			var fieldKey string
+			switch {
+			case f.chardata:
+				fieldKey = nvKey(key, "Value")
+			case f.attr:
+				fieldKey = nvKey(key, f.path[0])
+			default:
+				fieldKey = nvKey(key, strings.Join(f.path, "."))
+			}
+			if err := addNVValues(values, fieldKey, fv); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice, reflect.Array:
+		list, scalars, err := nvList(key, v)
+		if err != nil {
+			return err
+		}
+		if scalars {
+			if list != "" {
+				values.Set(key, list)
+			}
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := addNVValues(values, fmt.Sprintf("%s(%d)", key, i), v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if text, ok := nvText(v); ok {
+		values.Set(key, text)
+		return nil
+	}
+	return fmt.Errorf("%s: unsupported type %s", key, v.Type())
+}
+
+// nvList joins slice v of scalar values with commas. It returns false if the elements are not scalars.
+// Values which contain a comma cannot be sent and cause an error.
+func nvList(key string, v reflect.Value) (string, bool, error) {
+	elem := v.Type().Elem()
+	if _, ok := nvText(reflect.Zero(elem)); !ok || elem.Implements(xmlMarshalerType) {
+		return "", false, nil
+	}
+	texts := make([]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		text, _ := nvText(v.Index(i))
+		if strings.Contains(text, ",") {
+			return "", false, fmt.Errorf("%s: value %q contains comma", key, text)
+		}
+		texts = append(texts, text)
+	}
+	return strings.Join(texts, ","), true, nil
+}
+
+// nvText formats scalar value v. It returns false if v is not a scalar.
+func nvText(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// nvKey joins prefix and name with dot
+func nvKey(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
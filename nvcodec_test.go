@@ -0,0 +1,124 @@
+package shopping
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalNV(t *testing.T) {
+	s := NewService("")
+	tests := []struct {
+		name    string
+		request interface{}
+		want    url.Values
+	}{
+		{
+			name: "GetSingleItem",
+			request: s.NewGetSingleItemRequest().WithItemID("123").
+				WithIncludeSelector(IncludeSelectorSIDetails, IncludeSelectorSITextItemSpecifics).
+				WithVariationSpecifics("Color", "Red", "Blue").
+				WithVariationSpecifics("Size", "M"),
+			want: url.Values{
+				"ItemID":          {"123"},
+				"IncludeSelector": {"Details,ItemSpecifics"},
+				"VariationSpecifics.NameValueList(0).Name":  {"Color"},
+				"VariationSpecifics.NameValueList(0).Value": {"Red,Blue"},
+				"VariationSpecifics.NameValueList(1).Name":  {"Size"},
+				"VariationSpecifics.NameValueList(1).Value": {"M"},
+			},
+		},
+		{
+			name:    "GetMultipleItems",
+			request: s.NewGetMultipleItemsRequest().WithItemIDs("1", "2", "3"),
+			want: url.Values{
+				"ItemID": {"1,2,3"},
+			},
+		},
+		{
+			name:    "FindProducts",
+			request: s.NewFindProductsRequest().WithProductID(ProductIDCodeTypeISBN, "9780747532743").WithMaxEntries(5),
+			want: url.Values{
+				"MaxEntries":      {"5"},
+				"ProductID.type":  {"ISBN"},
+				"ProductID.Value": {"9780747532743"},
+			},
+		},
+		{
+			name: "GetShippingCosts",
+			request: s.NewGetShippingCostsRequest().WithItemID("1").WithDestinationCountryCode(CountryCodeUS).
+				WithDestinationPostalCode("95125").WithIncludeDetails(true),
+			want: url.Values{
+				"ItemID":                 {"1"},
+				"DestinationCountryCode": {"US"},
+				"DestinationPostalCode":  {"95125"},
+				"IncludeDetails":         {"true"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := marshalNV(tt.request)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMarshalNV_CommaInValue(t *testing.T) {
+	_, err := marshalNV(NewService("").NewGetSingleItemRequest().WithItemID("1").WithVariationSpecifics("Color", "Red, Blue"))
+	assert.Error(t, err)
+}
+
+// TestService_NameValueDocumentedSample checks the query against the sample URL
+// from https://developer.ebay.com/Devzone/shopping/docs/CallRef/GetMultipleItems.html
+func TestService_NameValueDocumentedSample(t *testing.T) {
+	b, err := ioutil.ReadFile(path.Join("testdata", "request", "nv", "multipleitems", "Basic.txt"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	want, err := url.ParseQuery(strings.TrimSpace(string(b)))
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the token is sent in the header
+	want.Del("appid")
+
+	service := NewService("").WithSiteID(SiteIDEbayUS).WithNameValueRequests(true)
+	request := service.NewGetMultipleItemsRequest().WithItemIDs(strings.Split(want.Get("ItemID"), ",")...)
+	request.version = want.Get("version")
+	got, err := request.marshal(request)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want.Encode(), string(got))
+	}
+}
+
+func TestService_WithNameValueRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		q := r.URL.Query()
+		assert.Equal(t, "GetItemStatus", q.Get("callname"))
+		assert.Equal(t, "77", q.Get("siteid"))
+		assert.Equal(t, "XML", q.Get("responseencoding"))
+		assert.Equal(t, EbayShoppingAPIVersion, q.Get("version"))
+		assert.Equal(t, "1,2", q.Get("ItemID"))
+		assert.Equal(t, "sandbox", q.Get("env"))
+		_, _ = w.Write([]byte(`<GetItemStatusResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>Success</Ack>` +
+			`<StatusItem><ItemID>1</ItemID></StatusItem><StatusItem><ItemID>2</ItemID></StatusItem></GetItemStatusResponse>`))
+	}))
+	defer server.Close()
+
+	service := NewService("").WithEndpoint(server.URL + "?env=sandbox").WithSiteID(SiteIDEbayDE).WithNameValueRequests(true)
+	res, err := service.NewGetItemStatusRequest().WithItemIDs("1", "2").ExecuteContext(context.Background())
+	if assert.NoError(t, err) {
+		assert.Len(t, res.Items, 2)
+	}
+}
@@ -0,0 +1,11 @@
+module github.com/hotafrika/ebay-shopping-api/oteltracing
+
+go 1.16
+
+require (
+	github.com/hotafrika/ebay-shopping-api v0.0.0-20261017095238-ddbfa40096e8
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v1.3.0
+	go.opentelemetry.io/otel/sdk v1.3.0
+	go.opentelemetry.io/otel/trace v1.3.0
+)
@@ -0,0 +1,74 @@
+// Package oteltracing creates OpenTelemetry spans for calls of shopping.Service.
+//
+//	tracer := otel.Tracer("github.com/hotafrika/ebay-shopping-api")
+//	service := shopping.NewService(token).WithTracer(oteltracing.NewTracer(tracer))
+//
+// It is a separate module, so OpenTelemetry is not required by applications which do not use it.
+package oteltracing
+
+import (
+	"context"
+	"fmt"
+
+	shopping "github.com/hotafrika/ebay-shopping-api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer implements shopping.Tracer using OpenTelemetry tracer
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer creates Tracer which starts client spans using tracer
+func NewTracer(tracer trace.Tracer) *Tracer {
+	return &Tracer{tracer: tracer}
+}
+
+// Start implements shopping.Tracer
+func (t *Tracer) Start(ctx context.Context, name string) (context.Context, shopping.Span) {
+	ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, Span{span: span}
+}
+
+// Span implements shopping.Span using OpenTelemetry span
+type Span struct {
+	span trace.Span
+}
+
+// SetAttributes implements shopping.Span
+func (s Span) SetAttributes(attributes ...shopping.SpanAttribute) {
+	kvs := make([]attribute.KeyValue, 0, len(attributes))
+	for _, a := range attributes {
+		kvs = append(kvs, keyValue(a))
+	}
+	s.span.SetAttributes(kvs...)
+}
+
+// RecordError implements shopping.Span
+func (s Span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+// End implements shopping.Span
+func (s Span) End() {
+	s.span.End()
+}
+
+// keyValue converts shopping.SpanAttribute to attribute.KeyValue
+func keyValue(a shopping.SpanAttribute) attribute.KeyValue {
+	switch v := a.Value.(type) {
+	case string:
+		return attribute.String(a.Key, v)
+	case int:
+		return attribute.Int(a.Key, v)
+	case bool:
+		return attribute.Bool(a.Key, v)
+	case []string:
+		return attribute.StringSlice(a.Key, v)
+	default:
+		return attribute.String(a.Key, fmt.Sprint(v))
+	}
+}
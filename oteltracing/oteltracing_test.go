@@ -0,0 +1,49 @@
+package oteltracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	shopping "github.com/hotafrika/ebay-shopping-api"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := NewTracer(provider.Tracer("test"))
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	_, span := tracer.Start(ctx, "ebay.shopping.GetSingleItem")
+	span.SetAttributes(
+		shopping.SpanAttribute{Key: shopping.SpanAttributeSiteID, Value: "0"},
+		shopping.SpanAttribute{Key: shopping.SpanAttributeAttempts, Value: 2},
+		shopping.SpanAttribute{Key: shopping.SpanAttributeCacheHit, Value: true},
+		shopping.SpanAttribute{Key: shopping.SpanAttributeErrorCodes, Value: []string{"10.12"}},
+	)
+	span.RecordError(errors.New("failed"))
+	span.End()
+	parent.End()
+
+	spans := recorder.Ended()
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+	s := spans[0]
+	assert.Equal(t, "ebay.shopping.GetSingleItem", s.Name())
+	assert.Equal(t, trace.SpanKindClient, s.SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), s.Parent().SpanID())
+	assert.Equal(t, codes.Error, s.Status().Code)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String(shopping.SpanAttributeSiteID, "0"),
+		attribute.Int(shopping.SpanAttributeAttempts, 2),
+		attribute.Bool(shopping.SpanAttributeCacheHit, true),
+		attribute.StringSlice(shopping.SpanAttributeErrorCodes, []string{"10.12"}),
+	}, s.Attributes())
+}
@@ -0,0 +1,9 @@
+module github.com/hotafrika/ebay-shopping-api/prommetrics
+
+go 1.16
+
+require (
+	github.com/hotafrika/ebay-shopping-api v0.0.0-20261017095238-ddbfa40096e8
+	github.com/prometheus/client_golang v1.11.0
+	github.com/stretchr/testify v1.7.0
+)
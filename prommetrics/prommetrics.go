@@ -0,0 +1,119 @@
+// Package prommetrics reports metrics of shopping.Service to Prometheus.
+//
+//	metrics, err := prommetrics.New(prometheus.DefaultRegisterer, "ebay")
+//	if err != nil {
+//		return err
+//	}
+//	service := shopping.NewService(token).WithMetrics(metrics)
+//
+// It is a separate module, so the Prometheus client is not required by applications which do not use it.
+package prommetrics
+
+import (
+	"strconv"
+	"time"
+
+	shopping "github.com/hotafrika/ebay-shopping-api"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics implements shopping.Metrics using Prometheus collectors
+type Metrics struct {
+	calls          *prometheus.CounterVec
+	callDuration   *prometheus.HistogramVec
+	errors         *prometheus.CounterVec
+	cache          *prometheus.CounterVec
+	retries        *prometheus.CounterVec
+	rateLimitWaits *prometheus.HistogramVec
+}
+
+// New creates Metrics and registers its collectors in reg. Namespace is a prefix of metric names.
+//
+// Metrics:
+//   - shopping_calls_total{operation, site_id, status, ack}
+//   - shopping_call_duration_seconds{operation, site_id}
+//   - shopping_errors_total{operation, site_id, error_code}
+//   - shopping_cache_lookups_total{operation, result} (result is "hit" or "miss")
+//   - shopping_retries_total{operation}
+//   - shopping_rate_limit_wait_seconds{operation}
+func New(reg prometheus.Registerer, namespace string) (*Metrics, error) {
+	m := &Metrics{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "shopping",
+			Name:      "calls_total",
+			Help:      "Number of calls to eBay Shopping API.",
+		}, []string{"operation", "site_id", "status", "ack"}),
+		callDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "shopping",
+			Name:      "call_duration_seconds",
+			Help:      "Duration of calls to eBay Shopping API.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "site_id"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "shopping",
+			Name:      "errors_total",
+			Help:      "Number of errors and warnings returned by eBay Shopping API.",
+		}, []string{"operation", "site_id", "error_code"}),
+		cache: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "shopping",
+			Name:      "cache_lookups_total",
+			Help:      "Number of lookups in the response cache.",
+		}, []string{"operation", "result"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "shopping",
+			Name:      "retries_total",
+			Help:      "Number of retried calls to eBay Shopping API.",
+		}, []string{"operation"}),
+		rateLimitWaits: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "shopping",
+			Name:      "rate_limit_wait_seconds",
+			Help:      "Time spent waiting for client-side rate limiters.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+	}
+	for _, c := range []prometheus.Collector{m.calls, m.callDuration, m.errors, m.cache, m.retries, m.rateLimitWaits} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ObserveCall implements shopping.Metrics
+func (m *Metrics) ObserveCall(call shopping.CallMetrics) {
+	operation := string(call.Operation)
+	status := ""
+	if call.StatusCode != 0 {
+		status = strconv.Itoa(call.StatusCode)
+	}
+	m.calls.WithLabelValues(operation, call.SiteID, status, call.Ack).Inc()
+	m.callDuration.WithLabelValues(operation, call.SiteID).Observe(call.Duration.Seconds())
+	for _, code := range call.ErrorCodes {
+		m.errors.WithLabelValues(operation, call.SiteID, code).Inc()
+	}
+}
+
+// ObserveCache implements shopping.Metrics
+func (m *Metrics) ObserveCache(operation shopping.EbayOperation, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cache.WithLabelValues(string(operation), result).Inc()
+}
+
+// ObserveRetry implements shopping.Metrics
+func (m *Metrics) ObserveRetry(operation shopping.EbayOperation) {
+	m.retries.WithLabelValues(string(operation)).Inc()
+}
+
+// ObserveRateLimitWait implements shopping.Metrics
+func (m *Metrics) ObserveRateLimitWait(operation shopping.EbayOperation, wait time.Duration) {
+	m.rateLimitWaits.WithLabelValues(string(operation)).Observe(wait.Seconds())
+}
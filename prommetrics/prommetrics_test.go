@@ -0,0 +1,54 @@
+package prommetrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	shopping "github.com/hotafrika/ebay-shopping-api"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m, err := New(reg, "test")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	m.ObserveCall(shopping.CallMetrics{
+		Operation:  shopping.OperationGetSingleItem,
+		SiteID:     "0",
+		StatusCode: 200,
+		Ack:        shopping.AckFailure,
+		ErrorCodes: []string{"10.12"},
+		Duration:   100 * time.Millisecond,
+	})
+	m.ObserveCache(shopping.OperationGetSingleItem, true)
+	m.ObserveRetry(shopping.OperationGetSingleItem)
+	m.ObserveRateLimitWait(shopping.OperationGetSingleItem, time.Second)
+
+	expected := `
+# HELP test_shopping_calls_total Number of calls to eBay Shopping API.
+# TYPE test_shopping_calls_total counter
+test_shopping_calls_total{ack="Failure",operation="GetSingleItem",site_id="0",status="200"} 1
+# HELP test_shopping_errors_total Number of errors and warnings returned by eBay Shopping API.
+# TYPE test_shopping_errors_total counter
+test_shopping_errors_total{error_code="10.12",operation="GetSingleItem",site_id="0"} 1
+# HELP test_shopping_cache_lookups_total Number of lookups in the response cache.
+# TYPE test_shopping_cache_lookups_total counter
+test_shopping_cache_lookups_total{operation="GetSingleItem",result="hit"} 1
+# HELP test_shopping_retries_total Number of retried calls to eBay Shopping API.
+# TYPE test_shopping_retries_total counter
+test_shopping_retries_total{operation="GetSingleItem"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"test_shopping_calls_total", "test_shopping_errors_total",
+		"test_shopping_cache_lookups_total", "test_shopping_retries_total"))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.rateLimitWaits))
+
+	_, err = New(reg, "test")
+	assert.Error(t, err)
+}
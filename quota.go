@@ -0,0 +1,190 @@
+package shopping
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// QuotaMode defines what happens when the daily call quota is exhausted
+type QuotaMode int
+
+const (
+	// QuotaFailFast makes calls fail with *QuotaExceededError
+	QuotaFailFast QuotaMode = iota
+	// QuotaBlock makes calls wait for the next eBay day
+	QuotaBlock
+)
+
+// CallQuota describes daily call limits of the application.
+// eBay enforces call limits per application per day. The day starts at midnight of eBay time (GMT).
+type CallQuota struct {
+	// Limit is a maximum number of calls per day for all operations. Zero means no limit.
+	Limit int64
+	// OperationLimits are maximum numbers of calls per day for particular operations
+	OperationLimits map[EbayOperation]int64
+	// Mode defines what happens when a limit is reached
+	Mode QuotaMode
+	// Threshold is a fraction of a limit (0..1). When it is reached, OnThreshold is called once per day.
+	Threshold float64
+	// OnThreshold is called when calls reach Threshold of a limit
+	OnThreshold func(usage QuotaUsage)
+	// Location is used to find the day boundary. Default is UTC (eBay time).
+	Location *time.Location
+}
+
+// QuotaUsage describes usage of a daily limit.
+// Operation is empty for the limit of all operations.
+type QuotaUsage struct {
+	Operation EbayOperation
+	Calls     int64
+	Limit     int64
+	// Reset is a time when the counter is reset
+	Reset time.Time
+}
+
+// QuotaExceededError is returned when the daily call quota is exhausted and QuotaFailFast mode is used
+type QuotaExceededError struct {
+	QuotaUsage
+}
+
+// Error implements error interface
+func (e *QuotaExceededError) Error() string {
+	if e.Operation == "" {
+		return fmt.Sprintf("daily call quota exceeded: %d of %d calls, resets at %s",
+			e.Calls, e.Limit, ToEbayDateTime(e.Reset.UTC()))
+	}
+	return fmt.Sprintf("daily call quota for %s exceeded: %d of %d calls, resets at %s",
+		e.Operation, e.Calls, e.Limit, ToEbayDateTime(e.Reset.UTC()))
+}
+
+// quotaCounter counts calls per operation per eBay day
+type quotaCounter struct {
+	mu       sync.Mutex
+	quota    CallQuota
+	reset    time.Time
+	total    int64
+	calls    map[EbayOperation]int64
+	notified map[EbayOperation]bool
+	now      func() time.Time
+}
+
+func newQuotaCounter(quota CallQuota) *quotaCounter {
+	return &quotaCounter{
+		quota:    quota,
+		calls:    make(map[EbayOperation]int64),
+		notified: make(map[EbayOperation]bool),
+		now:      time.Now,
+	}
+}
+
+// setQuota changes quota without resetting counters
+func (c *quotaCounter) setQuota(quota CallQuota) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	c.rollover(now)
+	c.quota = quota
+	c.reset = c.nextReset(now)
+}
+
+// rollover resets counters if a new day has started
+func (c *quotaCounter) rollover(now time.Time) {
+	if now.Before(c.reset) {
+		return
+	}
+	c.reset = c.nextReset(now)
+	c.total = 0
+	c.calls = make(map[EbayOperation]int64)
+	c.notified = make(map[EbayOperation]bool)
+}
+
+// nextReset returns the beginning of the next day
+func (c *quotaCounter) nextReset(now time.Time) time.Time {
+	loc := c.quota.Location
+	if loc == nil {
+		loc = UTC
+	}
+	t := now.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+}
+
+// exceeded returns usage of the exhausted limit (if any)
+func (c *quotaCounter) exceeded(operation EbayOperation) *QuotaUsage {
+	if c.quota.Limit > 0 && c.total >= c.quota.Limit {
+		return &QuotaUsage{Calls: c.total, Limit: c.quota.Limit, Reset: c.reset}
+	}
+	if limit := c.quota.OperationLimits[operation]; limit > 0 && c.calls[operation] >= limit {
+		return &QuotaUsage{Operation: operation, Calls: c.calls[operation], Limit: limit, Reset: c.reset}
+	}
+	return nil
+}
+
+// thresholds returns usages which have just reached the threshold
+func (c *quotaCounter) thresholds(operation EbayOperation) []QuotaUsage {
+	if c.quota.OnThreshold == nil || c.quota.Threshold <= 0 {
+		return nil
+	}
+	var usages []QuotaUsage
+	reached := func(calls, limit int64) bool {
+		return limit > 0 && float64(calls) >= float64(limit)*c.quota.Threshold
+	}
+	// the empty operation is used for the limit of all operations
+	if !c.notified[""] && reached(c.total, c.quota.Limit) {
+		c.notified[""] = true
+		usages = append(usages, QuotaUsage{Calls: c.total, Limit: c.quota.Limit, Reset: c.reset})
+	}
+	limit := c.quota.OperationLimits[operation]
+	if !c.notified[operation] && reached(c.calls[operation], limit) {
+		c.notified[operation] = true
+		usages = append(usages, QuotaUsage{Operation: operation, Calls: c.calls[operation], Limit: limit, Reset: c.reset})
+	}
+	return usages
+}
+
+// acquire counts a call of the operation.
+// If the quota is exhausted, it fails or waits for the next day according to the quota mode.
+func (c *quotaCounter) acquire(ctx context.Context, operation EbayOperation) error {
+	for {
+		c.mu.Lock()
+		c.rollover(c.now())
+		usage := c.exceeded(operation)
+		if usage == nil {
+			c.total++
+			c.calls[operation]++
+			usages := c.thresholds(operation)
+			onThreshold := c.quota.OnThreshold
+			c.mu.Unlock()
+			for _, u := range usages {
+				onThreshold(u)
+			}
+			return nil
+		}
+		mode := c.quota.Mode
+		c.mu.Unlock()
+
+		if mode != QuotaBlock {
+			return &QuotaExceededError{QuotaUsage: *usage}
+		}
+		timer := time.NewTimer(usage.Reset.Sub(c.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("waiting for call quota: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// usage returns numbers of calls per operation made during the current day
+func (c *quotaCounter) usage() map[EbayOperation]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rollover(c.now())
+	calls := make(map[EbayOperation]int64, len(c.calls))
+	for op, n := range c.calls {
+		calls[op] = n
+	}
+	return calls
+}
@@ -0,0 +1,109 @@
+package shopping
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket_Wait(t *testing.T) {
+	bucket := NewTokenBucket(20, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, bucket.Wait(ctx))
+	}
+	// 2 calls are allowed by burst, 2 more calls need 100ms
+	assert.True(t, time.Since(start) >= 90*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.True(t, errors.Is(bucket.Wait(ctx), context.DeadlineExceeded))
+}
+
+func TestService_WithRateLimit(t *testing.T) {
+	var calls int64
+	service := NewService("").
+		WithEndpoint("http://ebay.invalid/shopping").
+		WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, &calls)).
+		WithRateLimit(1000, 1).
+		WithOperationRateLimiter(OperationGeteBayTime, NewTokenBucket(0, 1))
+
+	_, err := service.NewGeteBayTimeRequest().Execute()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = service.NewGeteBayTimeRequest().ExecuteContext(ctx)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+}
+
+func TestService_WithCallQuota(t *testing.T) {
+	var calls int64
+	var usages []QuotaUsage
+	service := NewService("").
+		WithEndpoint("http://ebay.invalid/shopping").
+		WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, &calls)).
+		WithCallQuota(CallQuota{
+			Limit:           10,
+			OperationLimits: map[EbayOperation]int64{OperationGeteBayTime: 2},
+			Threshold:       0.5,
+			OnThreshold: func(usage QuotaUsage) {
+				usages = append(usages, usage)
+			},
+		})
+
+	for i := 0; i < 2; i++ {
+		_, err := service.NewGeteBayTimeRequest().Execute()
+		assert.NoError(t, err)
+	}
+	_, err := service.NewGeteBayTimeRequest().Execute()
+	var quotaErr *QuotaExceededError
+	if assert.True(t, errors.As(err, &quotaErr)) {
+		assert.Equal(t, OperationGeteBayTime, quotaErr.Operation)
+		assert.Equal(t, int64(2), quotaErr.Limit)
+	}
+	assert.Equal(t, int64(2), atomic.LoadInt64(&calls))
+	assert.Equal(t, map[EbayOperation]int64{OperationGeteBayTime: 2}, service.CallCounts())
+	if assert.Len(t, usages, 1) {
+		assert.Equal(t, OperationGeteBayTime, usages[0].Operation)
+		assert.Equal(t, int64(1), usages[0].Calls)
+	}
+}
+
+func TestQuotaCounter_DayBoundary(t *testing.T) {
+	now := time.Date(2021, 11, 27, 23, 59, 0, 0, UTC)
+	counter := newQuotaCounter(CallQuota{})
+	counter.now = func() time.Time { return now }
+	counter.setQuota(CallQuota{Limit: 1})
+
+	ctx := context.Background()
+	assert.NoError(t, counter.acquire(ctx, OperationGetSingleItem))
+	err := counter.acquire(ctx, OperationGetItemStatus)
+	var quotaErr *QuotaExceededError
+	if assert.True(t, errors.As(err, &quotaErr)) {
+		assert.Equal(t, time.Date(2021, 11, 28, 0, 0, 0, 0, UTC), quotaErr.Reset)
+	}
+
+	now = now.Add(time.Minute)
+	assert.NoError(t, counter.acquire(ctx, OperationGetItemStatus))
+	assert.Equal(t, map[EbayOperation]int64{OperationGetItemStatus: 1}, counter.usage())
+}
+
+func TestQuotaCounter_Block(t *testing.T) {
+	counter := newQuotaCounter(CallQuota{Limit: 1, Mode: QuotaBlock})
+	ctx := context.Background()
+	assert.NoError(t, counter.acquire(ctx, OperationGetSingleItem))
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	err := counter.acquire(ctx, OperationGetSingleItem)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+}
@@ -0,0 +1,110 @@
+package shopping
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate of calls to eBay.
+// Wait blocks until a call is allowed or ctx is done.
+//
+// *rate.Limiter from golang.org/x/time/rate satisfies this interface.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is a token-bucket RateLimiter.
+// The bucket holds up to burst tokens and is refilled with perSecond tokens per second.
+// Each call takes one token.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates new TokenBucket which allows perSecond calls per second
+// with bursts of up to burst calls. The bucket is full initially.
+func NewTokenBucket(perSecond float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait takes a token from the bucket. It blocks until the token is available or ctx is done.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+	// the token is reserved right away, so concurrent callers are queued
+	b.tokens--
+	if b.tokens >= 0 {
+		b.mu.Unlock()
+		return nil
+	}
+	if b.rate <= 0 {
+		b.tokens++
+		b.mu.Unlock()
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	wait := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.mu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// give back the reserved token
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// throttle waits for rate limiters and takes one call from the quota
+func (s *Service) throttle(ctx context.Context, operation EbayOperation) error {
+	if err := s.waitRateLimiters(ctx, operation); err != nil {
+		return err
+	}
+	return s.quota.acquire(ctx, operation)
+}
+
+// waitRateLimiters waits for the service rate limiters and observes the wait time in the service metrics
+func (s *Service) waitRateLimiters(ctx context.Context, operation EbayOperation) error {
+	l := s.operationLimiters[operation]
+	if l == nil && s.rateLimiter == nil {
+		return nil
+	}
+	start := time.Now()
+	defer func() {
+		s.metrics.ObserveRateLimitWait(operation, time.Since(start))
+	}()
+	if l != nil {
+		if err := l.Wait(ctx); err != nil {
+			return fmt.Errorf("waiting for %s rate limiter: %w", operation, err)
+		}
+	}
+	if s.rateLimiter != nil {
+		if err := s.rateLimiter.Wait(ctx); err != nil {
+			return fmt.Errorf("waiting for rate limiter: %w", err)
+		}
+	}
+	return nil
+}
@@ -1,9 +1,11 @@
 package shopping
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
 // FindProductsRequest represents eBay FindProducts call request
@@ -92,8 +94,8 @@ func (r *FindProductsRequest) WithMaxEntries(limit int) *FindProductsRequest {
 // The product identifier is expressed as a string value, and the type of product identifier
 // is expressed in the type attribute.
 type ProductID struct {
-	ProductIDCodeType string `xml:"type,attr"`
-	ProductIDType     string `xml:",cdata"`
+	ProductIDCodeType string `xml:"type,attr" json:"Type"`
+	ProductIDType     string `xml:",cdata" json:"Value"`
 }
 
 // WithProductID adds ProductID to request
@@ -164,28 +166,33 @@ func (r *FindProductsRequest) WithSortOrder(sortBy SortOrderOption) *FindProduct
 // GetPage executes FindProductsRequest for page #
 // Valid pages # 1 - 10000+
 func (r *FindProductsRequest) GetPage(page int) (FindProductsResponse, error) {
+	return r.GetPageContext(context.Background(), page)
+}
+
+// GetPageContext executes FindProductsRequest for page # with the given context.
+// The request is aborted when ctx is canceled or its deadline is exceeded.
+// If eBay responds with Ack Failure or PartialFailure, the decoded response is returned with *APIError.
+// Valid pages # 1 - 10000+
+func (r *FindProductsRequest) GetPageContext(ctx context.Context, page int) (res FindProductsResponse, err error) {
 	if page < 1 {
 		page = 1
 	}
+	ctx, span := r.startSpan(ctx, SpanAttribute{Key: SpanAttributePageNumber, Value: page})
+	defer func() { span.end(&res, err) }()
 	r.WithPageNumber(page)
+	if err := r.Validate(); err != nil {
+		return FindProductsResponse{}, err
+	}
 	body, err := r.getBody()
 	if err != nil {
 		return FindProductsResponse{}, fmt.Errorf("unable to serialize req body: %w", err)
 	}
-	// TODO check content type
-	res, err := r.Client.R().SetBody(body).Post(r.URL)
-	if err != nil {
-		return FindProductsResponse{}, fmt.Errorf("sending req: %w", err)
-	}
-	if res.StatusCode() != 200 {
-		return FindProductsResponse{}, fmt.Errorf("status code %d: %s", res.StatusCode(), res.String())
-	}
 	ar := FindProductsResponse{}
-	err = xml.Unmarshal(res.Body(), &ar)
-	if err != nil {
-		return FindProductsResponse{}, fmt.Errorf("parsing response body: %w", err)
+	err = r.execute(ctx, body, &ar)
+	if err != nil && !isAPIFailure(err) {
+		return FindProductsResponse{}, err
 	}
-	return ar, nil
+	return ar, err
 }
 
 // Execute executes FindProductsRequest for the first page
@@ -193,6 +200,32 @@ func (r *FindProductsRequest) Execute() (FindProductsResponse, error) {
 	return r.GetPage(1)
 }
 
+// ExecuteContext executes FindProductsRequest for the first page with the given context
+func (r *FindProductsRequest) ExecuteContext(ctx context.Context) (FindProductsResponse, error) {
+	return r.GetPageContext(ctx, 1)
+}
+
+// Validate checks that the request has QueryKeywords, ProductID or CategoryID
+// and that QueryKeywords has from 3 alphanumeric characters up to 350 characters
+func (r *FindProductsRequest) Validate() error {
+	v := validator{}
+	if strings.TrimSpace(r.QueryKeywords) == "" && r.ProductID == nil && strings.TrimSpace(r.CategoryID) == "" {
+		v.add("QueryKeywords", "QueryKeywords, ProductID or CategoryID is required")
+	}
+	if r.QueryKeywords != "" {
+		if n := utf8.RuneCountInString(r.QueryKeywords); n > MaxQueryKeywordsLength {
+			v.add("QueryKeywords", "has %d characters, max %d", n, MaxQueryKeywordsLength)
+		}
+		if countAlphanumerics(r.QueryKeywords) < MinQueryKeywordsAlphanumerics {
+			v.add("QueryKeywords", "must contain at least %d alphanumeric characters", MinQueryKeywordsAlphanumerics)
+		}
+	}
+	if r.ProductID != nil && strings.TrimSpace(r.ProductID.ProductIDType) == "" {
+		v.add("ProductID", "value is required")
+	}
+	return v.err(OperationFindProducts)
+}
+
 // GetBody return FindProductsRequest body as XML
 func (r *FindProductsRequest) GetBody() ([]byte, error) {
 	b, err := xml.MarshalIndent(r, "", "  ")
@@ -203,11 +236,7 @@ func (r *FindProductsRequest) GetBody() ([]byte, error) {
 }
 
 func (r *FindProductsRequest) getBody() ([]byte, error) {
-	b, err := xml.Marshal(r)
-	if err != nil {
-		return b, err
-	}
-	return append([]byte(xml.Header), b...), err
+	return r.marshal(r)
 }
 
 /*
@@ -222,9 +251,8 @@ type GetCategoryInfoRequest struct {
 	XMLName xml.Name `xml:"urn:ebay:apis:eBLBaseComponents GetCategoryInfoRequest"`
 	RequestBasic
 	RequestStandard
-	CategoryID         string              `xml:"CategoryID,omitempty"`
-	IncludeSelectorMap map[string]struct{} `xml:"-"`
-	IncludeSelector    string              `xml:"IncludeSelector,omitempty"`
+	CategoryID      string `xml:"CategoryID,omitempty"`
+	IncludeSelector string `xml:"IncludeSelector,omitempty"`
 }
 
 // WithCategoryID adds categoryID to GetCategoryInfoRequest
@@ -245,41 +273,59 @@ func (r *GetCategoryInfoRequest) WithCategoryID(categoryID string) *GetCategoryI
 // This field is included and its value is set to ChildCategories if the user wishes to retrieve all of
 // the specified category's children categories (one category level down in eBay categorical hierarchy).
 // If the specified category is a leaf category (and has no children), this filter has no effect on the output.
+// Selectors are serialized in the order they were added. Duplicates are ignored.
 func (r *GetCategoryInfoRequest) WithIncludeSelector(selectors ...IncludeSelectorGCIOption) *GetCategoryInfoRequest {
-	if len(r.IncludeSelectorMap) == 0 {
-		r.IncludeSelectorMap = make(map[string]struct{})
-	}
 	for _, s := range selectors {
-		r.IncludeSelectorMap[string(s)] = struct{}{}
+		r.IncludeSelector = addToList(r.IncludeSelector, string(s))
 	}
-	var is []string
-	for k := range r.IncludeSelectorMap {
-		is = append(is, k)
+	return r
+}
+
+// WithoutIncludeSelector removes selector options from request
+func (r *GetCategoryInfoRequest) WithoutIncludeSelector(selectors ...IncludeSelectorGCIOption) *GetCategoryInfoRequest {
+	for _, s := range selectors {
+		r.IncludeSelector = removeFromList(r.IncludeSelector, string(s))
 	}
-	r.IncludeSelector = strings.Join(is, ",")
+	return r
+}
+
+// ResetIncludeSelector removes all selector options from request
+func (r *GetCategoryInfoRequest) ResetIncludeSelector() *GetCategoryInfoRequest {
+	r.IncludeSelector = ""
 	return r
 }
 
 // Execute executes GetCategoryInfoRequest
 func (r *GetCategoryInfoRequest) Execute() (GetCategoryInfoResponse, error) {
+	return r.ExecuteContext(context.Background())
+}
+
+// ExecuteContext executes GetCategoryInfoRequest with the given context.
+// The request is aborted when ctx is canceled or its deadline is exceeded.
+// If eBay responds with Ack Failure or PartialFailure, the decoded response is returned with *APIError.
+func (r *GetCategoryInfoRequest) ExecuteContext(ctx context.Context) (res GetCategoryInfoResponse, err error) {
+	ctx, span := r.startSpan(ctx)
+	defer func() { span.end(&res, err) }()
+	if err := r.Validate(); err != nil {
+		return GetCategoryInfoResponse{}, err
+	}
 	body, err := r.getBody()
 	if err != nil {
 		return GetCategoryInfoResponse{}, fmt.Errorf("unable to serialize req body: %w", err)
 	}
-	// TODO check content type
-	res, err := r.Client.R().SetBody(body).Post(r.URL)
-	if err != nil {
-		return GetCategoryInfoResponse{}, fmt.Errorf("sending req: %w", err)
-	}
-	if res.StatusCode() != 200 {
-		return GetCategoryInfoResponse{}, fmt.Errorf("status code %d: %s", res.StatusCode(), res.String())
-	}
 	ar := GetCategoryInfoResponse{}
-	err = xml.Unmarshal(res.Body(), &ar)
-	if err != nil {
-		return GetCategoryInfoResponse{}, fmt.Errorf("parsing response body: %w", err)
+	err = r.execute(ctx, body, &ar)
+	if err != nil && !isAPIFailure(err) {
+		return GetCategoryInfoResponse{}, err
 	}
-	return ar, nil
+	return ar, err
+}
+
+// Validate checks that the request has CategoryID
+func (r *GetCategoryInfoRequest) Validate() error {
+	v := validator{}
+	v.required("CategoryID", r.CategoryID)
+	return v.err(OperationGetCategoryInfo)
 }
 
 // GetBody return GetCategoryInfoRequest body as XML
@@ -292,11 +338,7 @@ func (r *GetCategoryInfoRequest) GetBody() ([]byte, error) {
 }
 
 func (r *GetCategoryInfoRequest) getBody() ([]byte, error) {
-	b, err := xml.Marshal(r)
-	if err != nil {
-		return b, err
-	}
-	return append([]byte(xml.Header), b...), err
+	return r.marshal(r)
 }
 
 /*
@@ -310,20 +352,33 @@ type GeteBayTimeRequest struct {
 
 // Execute executes GeteBayTimeRequest
 func (r *GeteBayTimeRequest) Execute() (GeteBayTimeResponse, error) {
-	// TODO check content type
-	res, err := r.Client.R().Post(r.URL)
-	if err != nil {
-		return GeteBayTimeResponse{}, fmt.Errorf("sending req: %w", err)
+	return r.ExecuteContext(context.Background())
+}
+
+// ExecuteContext executes GeteBayTimeRequest with the given context.
+// The request is aborted when ctx is canceled or its deadline is exceeded.
+// If eBay responds with Ack Failure or PartialFailure, the decoded response is returned with *APIError.
+func (r *GeteBayTimeRequest) ExecuteContext(ctx context.Context) (res GeteBayTimeResponse, err error) {
+	ctx, span := r.startSpan(ctx)
+	defer func() { span.end(&res, err) }()
+	if err := r.Validate(); err != nil {
+		return GeteBayTimeResponse{}, err
 	}
-	if res.StatusCode() != 200 {
-		return GeteBayTimeResponse{}, fmt.Errorf("status code %d: %s", res.StatusCode(), res.String())
+	body, err := r.getBody()
+	if err != nil {
+		return GeteBayTimeResponse{}, fmt.Errorf("unable to serialize req body: %w", err)
 	}
 	ar := GeteBayTimeResponse{}
-	err = xml.Unmarshal(res.Body(), &ar)
-	if err != nil {
-		return GeteBayTimeResponse{}, fmt.Errorf("parsing response body: %w", err)
+	err = r.execute(ctx, body, &ar)
+	if err != nil && !isAPIFailure(err) {
+		return GeteBayTimeResponse{}, err
 	}
-	return ar, nil
+	return ar, err
+}
+
+// Validate always returns nil because GeteBayTimeRequest has no fields
+func (r *GeteBayTimeRequest) Validate() error {
+	return nil
 }
 
 // GetBody return GeteBayTimeRequest body as XML
@@ -335,6 +390,10 @@ func (r *GeteBayTimeRequest) GetBody() ([]byte, error) {
 	return append([]byte(xml.Header), b...), err
 }
 
+func (r *GeteBayTimeRequest) getBody() ([]byte, error) {
+	return r.marshal(r)
+}
+
 /*
 ===================================================
 */
@@ -345,48 +404,68 @@ func (r *GeteBayTimeRequest) GetBody() ([]byte, error) {
 type GetItemStatusRequest struct {
 	XMLName xml.Name `xml:"urn:ebay:apis:eBLBaseComponents GetItemStatusRequest"`
 	RequestBasic
-	ItemIDMap map[string]struct{} `xml:"-"`
-	ItemIDs   []string            `xml:"ItemID,omitempty"`
+	ItemIDs []string `xml:"ItemID,omitempty"`
 }
 
 // WithItemID adds items IDs to request
 // The unique identifier of the eBay listing to retrieve. You can retrieve the status of up to 20 listings per call,
 // and a separate ItemID field is required for each listing.
+// IDs are serialized in the order they were added. Duplicates are ignored.
 func (r *GetItemStatusRequest) WithItemID(itemIDs ...string) *GetItemStatusRequest {
-	if len(r.ItemIDMap) == 0 {
-		r.ItemIDMap = make(map[string]struct{})
-	}
-	for _, id := range itemIDs {
-		r.ItemIDMap[id] = struct{}{}
-	}
-	var ids []string
-	for k := range r.ItemIDMap {
-		ids = append(ids, k)
-	}
-	r.ItemIDs = ids
+	r.ItemIDs = appendUnique(r.ItemIDs, itemIDs...)
+	return r
+}
+
+// WithItemIDs replaces items IDs in request keeping the given order
+// You can retrieve the status of up to 20 listings per call.
+func (r *GetItemStatusRequest) WithItemIDs(itemIDs ...string) *GetItemStatusRequest {
+	r.ItemIDs = appendUnique(nil, itemIDs...)
+	return r
+}
+
+// WithoutItemID removes items IDs from request
+func (r *GetItemStatusRequest) WithoutItemID(itemIDs ...string) *GetItemStatusRequest {
+	r.ItemIDs = removeValues(r.ItemIDs, itemIDs...)
+	return r
+}
+
+// ResetItemIDs removes all items IDs from request
+func (r *GetItemStatusRequest) ResetItemIDs() *GetItemStatusRequest {
+	r.ItemIDs = nil
 	return r
 }
 
 // Execute executes GetItemStatusRequest
 func (r *GetItemStatusRequest) Execute() (GetItemStatusResponse, error) {
+	return r.ExecuteContext(context.Background())
+}
+
+// ExecuteContext executes GetItemStatusRequest with the given context.
+// The request is aborted when ctx is canceled or its deadline is exceeded.
+// If eBay responds with Ack Failure or PartialFailure, the decoded response is returned with *APIError.
+func (r *GetItemStatusRequest) ExecuteContext(ctx context.Context) (res GetItemStatusResponse, err error) {
+	ctx, span := r.startSpan(ctx, SpanAttribute{Key: SpanAttributeItemCount, Value: len(r.ItemIDs)})
+	defer func() { span.end(&res, err) }()
+	if err := r.Validate(); err != nil {
+		return GetItemStatusResponse{}, err
+	}
 	body, err := r.getBody()
 	if err != nil {
 		return GetItemStatusResponse{}, fmt.Errorf("unable to serialize req body: %w", err)
 	}
-	// TODO check content type
-	res, err := r.Client.R().SetBody(body).Post(r.URL)
-	if err != nil {
-		return GetItemStatusResponse{}, fmt.Errorf("sending req: %w", err)
-	}
-	if res.StatusCode() != 200 {
-		return GetItemStatusResponse{}, fmt.Errorf("status code %d: %s", res.StatusCode(), res.String())
-	}
 	ar := GetItemStatusResponse{}
-	err = xml.Unmarshal(res.Body(), &ar)
-	if err != nil {
-		return GetItemStatusResponse{}, fmt.Errorf("parsing response body: %w", err)
+	err = r.execute(ctx, body, &ar)
+	if err != nil && !isAPIFailure(err) {
+		return GetItemStatusResponse{}, err
 	}
-	return ar, nil
+	return ar, err
+}
+
+// Validate checks that the request has from 1 up to 20 ItemIDs
+func (r *GetItemStatusRequest) Validate() error {
+	v := validator{}
+	v.itemIDs("ItemID", r.ItemIDs)
+	return v.err(OperationGetItemStatus)
 }
 
 // GetBody return GetItemStatusRequest body as XML
@@ -399,11 +478,7 @@ func (r *GetItemStatusRequest) GetBody() ([]byte, error) {
 }
 
 func (r *GetItemStatusRequest) getBody() ([]byte, error) {
-	b, err := xml.Marshal(r)
-	if err != nil {
-		return b, err
-	}
-	return append([]byte(xml.Header), b...), err
+	return r.marshal(r)
 }
 
 /*
@@ -415,24 +490,30 @@ func (r *GetItemStatusRequest) getBody() ([]byte, error) {
 type GetMultipleItemsRequest struct {
 	XMLName xml.Name `xml:"urn:ebay:apis:eBLBaseComponents GetMultipleItemsRequest"`
 	RequestBasic
-	IncludeSelectorMap map[string]struct{} `xml:"-"`
-	IncludeSelector    string              `xml:"IncludeSelector,omitempty"`
-	ItemIDs            []string            `xml:"ItemID,omitempty"`
+	IncludeSelector string   `xml:"IncludeSelector,omitempty"`
+	ItemIDs         []string `xml:"ItemID,omitempty"`
 }
 
 // WithIncludeSelector adds selector options to request
+// Selectors are serialized in the order they were added. Duplicates are ignored.
 func (r *GetMultipleItemsRequest) WithIncludeSelector(selectors ...IncludeSelectorGMIOption) *GetMultipleItemsRequest {
-	if len(r.IncludeSelectorMap) == 0 {
-		r.IncludeSelectorMap = make(map[string]struct{})
-	}
 	for _, s := range selectors {
-		r.IncludeSelectorMap[string(s)] = struct{}{}
+		r.IncludeSelector = addToList(r.IncludeSelector, string(s))
 	}
-	var is []string
-	for k := range r.IncludeSelectorMap {
-		is = append(is, k)
+	return r
+}
+
+// WithoutIncludeSelector removes selector options from request
+func (r *GetMultipleItemsRequest) WithoutIncludeSelector(selectors ...IncludeSelectorGMIOption) *GetMultipleItemsRequest {
+	for _, s := range selectors {
+		r.IncludeSelector = removeFromList(r.IncludeSelector, string(s))
 	}
-	r.IncludeSelector = strings.Join(is, ",")
+	return r
+}
+
+// ResetIncludeSelector removes all selector options from request
+func (r *GetMultipleItemsRequest) ResetIncludeSelector() *GetMultipleItemsRequest {
+	r.IncludeSelector = ""
 	return r
 }
 
@@ -440,8 +521,9 @@ func (r *GetMultipleItemsRequest) WithIncludeSelector(selectors ...IncludeSelect
 // The uniqe ID that identifies the listing for which to retrieve the data.
 // You can provide a maximum of 20 ItemID values.
 // Max length: 19 (Note: The eBay database specifies 38. Currently, StatusItem IDs are usually 9 to 12 digits).
+// IDs are serialized in the order they were added. Duplicates are ignored.
 func (r *GetMultipleItemsRequest) WithItemID(itemIDs ...string) *GetMultipleItemsRequest {
-	r.ItemIDs = append(r.ItemIDs, itemIDs...)
+	r.ItemIDs = appendUnique(r.ItemIDs, itemIDs...)
 	return r
 }
 
@@ -450,30 +532,53 @@ func (r *GetMultipleItemsRequest) WithItemID(itemIDs ...string) *GetMultipleItem
 // You can provide a maximum of 20 ItemID values.
 // Max length: 19 (Note: The eBay database specifies 38. Currently, StatusItem IDs are usually 9 to 12 digits).
 func (r *GetMultipleItemsRequest) WithItemIDs(itemIDs ...string) *GetMultipleItemsRequest {
-	r.ItemIDs = itemIDs
+	r.ItemIDs = appendUnique(nil, itemIDs...)
+	return r
+}
+
+// WithoutItemID removes items IDs from request
+func (r *GetMultipleItemsRequest) WithoutItemID(itemIDs ...string) *GetMultipleItemsRequest {
+	r.ItemIDs = removeValues(r.ItemIDs, itemIDs...)
+	return r
+}
+
+// ResetItemIDs removes all items IDs from request
+func (r *GetMultipleItemsRequest) ResetItemIDs() *GetMultipleItemsRequest {
+	r.ItemIDs = nil
 	return r
 }
 
 // Execute executes GetMultipleItemsRequest
 func (r *GetMultipleItemsRequest) Execute() (GetMultipleItemsResponse, error) {
+	return r.ExecuteContext(context.Background())
+}
+
+// ExecuteContext executes GetMultipleItemsRequest with the given context.
+// The request is aborted when ctx is canceled or its deadline is exceeded.
+// If eBay responds with Ack Failure or PartialFailure, the decoded response is returned with *APIError.
+func (r *GetMultipleItemsRequest) ExecuteContext(ctx context.Context) (res GetMultipleItemsResponse, err error) {
+	ctx, span := r.startSpan(ctx, SpanAttribute{Key: SpanAttributeItemCount, Value: len(r.ItemIDs)})
+	defer func() { span.end(&res, err) }()
+	if err := r.Validate(); err != nil {
+		return GetMultipleItemsResponse{}, err
+	}
 	body, err := r.getBody()
 	if err != nil {
 		return GetMultipleItemsResponse{}, fmt.Errorf("unable to serialize req body: %w", err)
 	}
-	// TODO check content type
-	res, err := r.Client.R().SetBody(body).Post(r.URL)
-	if err != nil {
-		return GetMultipleItemsResponse{}, fmt.Errorf("sending req: %w", err)
-	}
-	if res.StatusCode() != 200 {
-		return GetMultipleItemsResponse{}, fmt.Errorf("status code %d: %s", res.StatusCode(), res.String())
-	}
 	ar := GetMultipleItemsResponse{}
-	err = xml.Unmarshal(res.Body(), &ar)
-	if err != nil {
-		return GetMultipleItemsResponse{}, fmt.Errorf("parsing response body: %w", err)
+	err = r.execute(ctx, body, &ar)
+	if err != nil && !isAPIFailure(err) {
+		return GetMultipleItemsResponse{}, err
 	}
-	return ar, nil
+	return ar, err
+}
+
+// Validate checks that the request has from 1 up to 20 ItemIDs
+func (r *GetMultipleItemsRequest) Validate() error {
+	v := validator{}
+	v.itemIDs("ItemID", r.ItemIDs)
+	return v.err(OperationGetMultipleItems)
 }
 
 // GetBody return GetMultipleItemsRequest body as XML
@@ -486,11 +591,7 @@ func (r *GetMultipleItemsRequest) GetBody() ([]byte, error) {
 }
 
 func (r *GetMultipleItemsRequest) getBody() ([]byte, error) {
-	b, err := xml.Marshal(r)
-	if err != nil {
-		return b, err
-	}
-	return append([]byte(xml.Header), b...), err
+	return r.marshal(r)
 }
 
 /*
@@ -505,17 +606,17 @@ type GetShippingCostsRequest struct {
 	XMLName xml.Name `xml:"urn:ebay:apis:eBLBaseComponents GetShippingCostsRequest"`
 	RequestBasic
 	// DestinationCountryCode from https://developer.ebay.com/Devzone/shopping/docs/CallRef/types/CountryCodeType.html
-	DestinationCountryCode string `xml:"DestinationCountryCode,omitempty"`
-	DestinationPostalCode  string `xml:"DestinationPostalCode,omitempty"`
-	IncludeDetails         bool   `xml:"IncludeDetails,omitempty"`
-	ItemID                 string `xml:"ItemID"`
-	QuantitySold           int    `xml:"QuantitySold,omitempty"`
+	DestinationCountryCode CountryCode `xml:"DestinationCountryCode,omitempty"`
+	DestinationPostalCode  string      `xml:"DestinationPostalCode,omitempty"`
+	IncludeDetails         bool        `xml:"IncludeDetails,omitempty"`
+	ItemID                 string      `xml:"ItemID"`
+	QuantitySold           int         `xml:"QuantitySold,omitempty"`
 }
 
 // WithDestinationCountryCode adds code from
 // Destination country code. If DestinationCountryCode is US, postal code is required and represents US zip code.
 // https://developer.ebay.com/Devzone/shopping/docs/CallRef/types/CountryCodeType.html
-func (r *GetShippingCostsRequest) WithDestinationCountryCode(code string) *GetShippingCostsRequest {
+func (r *GetShippingCostsRequest) WithDestinationCountryCode(code CountryCode) *GetShippingCostsRequest {
 	r.DestinationCountryCode = code
 	return r
 }
@@ -550,24 +651,45 @@ func (r *GetShippingCostsRequest) WithQuantitySold(sold int) *GetShippingCostsRe
 
 // Execute executes GetShippingCostsRequest
 func (r *GetShippingCostsRequest) Execute() (GetShippingCostsResponse, error) {
+	return r.ExecuteContext(context.Background())
+}
+
+// ExecuteContext executes GetShippingCostsRequest with the given context.
+// The request is aborted when ctx is canceled or its deadline is exceeded.
+// If eBay responds with Ack Failure or PartialFailure, the decoded response is returned with *APIError.
+func (r *GetShippingCostsRequest) ExecuteContext(ctx context.Context) (res GetShippingCostsResponse, err error) {
+	ctx, span := r.startSpan(ctx)
+	defer func() { span.end(&res, err) }()
+	if err := r.Validate(); err != nil {
+		return GetShippingCostsResponse{}, err
+	}
 	body, err := r.getBody()
 	if err != nil {
 		return GetShippingCostsResponse{}, fmt.Errorf("unable to serialize req body: %w", err)
 	}
-	// TODO check content type
-	res, err := r.Client.R().SetBody(body).Post(r.URL)
-	if err != nil {
-		return GetShippingCostsResponse{}, fmt.Errorf("sending req: %w", err)
+	ar := GetShippingCostsResponse{}
+	err = r.execute(ctx, body, &ar)
+	if err != nil && !isAPIFailure(err) {
+		return GetShippingCostsResponse{}, err
+	}
+	return ar, err
+}
+
+// Validate checks that the request has ItemID, DestinationCountryCode is a known eBay country code
+// and DestinationPostalCode is set for US destination
+func (r *GetShippingCostsRequest) Validate() error {
+	v := validator{}
+	v.required("ItemID", r.ItemID)
+	if r.DestinationCountryCode != "" && !r.DestinationCountryCode.Valid() {
+		v.add("DestinationCountryCode", "unknown country code %q", r.DestinationCountryCode)
 	}
-	if res.StatusCode() != 200 {
-		return GetShippingCostsResponse{}, fmt.Errorf("status code %d: %s", res.StatusCode(), res.String())
+	if r.DestinationCountryCode == CountryCodeUS && strings.TrimSpace(r.DestinationPostalCode) == "" {
+		v.add("DestinationPostalCode", "is required for country code %q", r.DestinationCountryCode)
 	}
-	ar := GetShippingCostsResponse{}
-	err = xml.Unmarshal(res.Body(), &ar)
-	if err != nil {
-		return GetShippingCostsResponse{}, fmt.Errorf("parsing response body: %w", err)
+	if r.QuantitySold < 0 {
+		v.add("QuantitySold", "must not be negative")
 	}
-	return ar, nil
+	return v.err(OperationGetShippingCosts)
 }
 
 // GetBody return GetShippingCostsRequest body as XML
@@ -580,11 +702,7 @@ func (r *GetShippingCostsRequest) GetBody() ([]byte, error) {
 }
 
 func (r *GetShippingCostsRequest) getBody() ([]byte, error) {
-	b, err := xml.Marshal(r)
-	if err != nil {
-		return b, err
-	}
-	return append([]byte(xml.Header), b...), err
+	return r.marshal(r)
 }
 
 /*
@@ -598,7 +716,6 @@ type GetSingleItemRequest struct {
 	XMLName xml.Name `xml:"urn:ebay:apis:eBLBaseComponents GetSingleItemRequest"`
 	RequestBasic
 	IncludeSelector    string              `xml:"IncludeSelector,omitempty"`
-	IncludeSelectorMap map[string]struct{} `xml:"-"`
 	ItemID             string              `xml:"ItemID"`
 	VariationSKU       string              `xml:"VariationSKU,omitempty"`
 	VariationSpecifics *VariationSpecifics `xml:"VariationSpecifics,omitempty"`
@@ -617,18 +734,25 @@ type NameValueListType struct {
 }
 
 // WithIncludeSelector adds selector options to request
+// Selectors are serialized in the order they were added. Duplicates are ignored.
 func (r *GetSingleItemRequest) WithIncludeSelector(selectors ...IncludeSelectorGSIOption) *GetSingleItemRequest {
-	if len(r.IncludeSelectorMap) == 0 {
-		r.IncludeSelectorMap = make(map[string]struct{})
-	}
 	for _, s := range selectors {
-		r.IncludeSelectorMap[string(s)] = struct{}{}
+		r.IncludeSelector = addToList(r.IncludeSelector, string(s))
 	}
-	var is []string
-	for k := range r.IncludeSelectorMap {
-		is = append(is, k)
+	return r
+}
+
+// WithoutIncludeSelector removes selector options from request
+func (r *GetSingleItemRequest) WithoutIncludeSelector(selectors ...IncludeSelectorGSIOption) *GetSingleItemRequest {
+	for _, s := range selectors {
+		r.IncludeSelector = removeFromList(r.IncludeSelector, string(s))
 	}
-	r.IncludeSelector = strings.Join(is, ",")
+	return r
+}
+
+// ResetIncludeSelector removes all selector options from request
+func (r *GetSingleItemRequest) ResetIncludeSelector() *GetSingleItemRequest {
+	r.IncludeSelector = ""
 	return r
 }
 
@@ -664,24 +788,35 @@ func (r *GetSingleItemRequest) WithVariationSpecifics(name string, values ...str
 
 // Execute executes GetSingleItemRequest
 func (r *GetSingleItemRequest) Execute() (GetSingleItemResponse, error) {
+	return r.ExecuteContext(context.Background())
+}
+
+// ExecuteContext executes GetSingleItemRequest with the given context.
+// The request is aborted when ctx is canceled or its deadline is exceeded.
+// If eBay responds with Ack Failure or PartialFailure, the decoded response is returned with *APIError.
+func (r *GetSingleItemRequest) ExecuteContext(ctx context.Context) (res GetSingleItemResponse, err error) {
+	ctx, span := r.startSpan(ctx)
+	defer func() { span.end(&res, err) }()
+	if err := r.Validate(); err != nil {
+		return GetSingleItemResponse{}, err
+	}
 	body, err := r.getBody()
 	if err != nil {
 		return GetSingleItemResponse{}, fmt.Errorf("unable to serialize req body: %w", err)
 	}
-	// TODO check content type
-	res, err := r.Client.R().SetBody(body).Post(r.URL)
-	if err != nil {
-		return GetSingleItemResponse{}, fmt.Errorf("sending req: %w", err)
-	}
-	if res.StatusCode() != 200 {
-		return GetSingleItemResponse{}, fmt.Errorf("status code %d: %s", res.StatusCode(), res.String())
-	}
 	ar := GetSingleItemResponse{}
-	err = xml.Unmarshal(res.Body(), &ar)
-	if err != nil {
-		return GetSingleItemResponse{}, fmt.Errorf("parsing response body: %w", err)
+	err = r.execute(ctx, body, &ar)
+	if err != nil && !isAPIFailure(err) {
+		return GetSingleItemResponse{}, err
 	}
-	return ar, nil
+	return ar, err
+}
+
+// Validate checks that the request has ItemID
+func (r *GetSingleItemRequest) Validate() error {
+	v := validator{}
+	v.required("ItemID", r.ItemID)
+	return v.err(OperationGetSingleItem)
 }
 
 // GetBody return GetSingleItemRequest body as XML
@@ -694,11 +829,7 @@ func (r *GetSingleItemRequest) GetBody() ([]byte, error) {
 }
 
 func (r *GetSingleItemRequest) getBody() ([]byte, error) {
-	b, err := xml.Marshal(r)
-	if err != nil {
-		return b, err
-	}
-	return append([]byte(xml.Header), b...), err
+	return r.marshal(r)
 }
 
 /*
@@ -709,24 +840,30 @@ func (r *GetSingleItemRequest) getBody() ([]byte, error) {
 type GetUserProfileRequest struct {
 	XMLName xml.Name `xml:"urn:ebay:apis:eBLBaseComponents GetUserProfileRequest"`
 	RequestBasic
-	IncludeSelector    string              `xml:"IncludeSelector,omitempty"`
-	IncludeSelectorMap map[string]struct{} `xml:"-"`
-	UserID             string              `xml:"UserID"`
+	IncludeSelector string `xml:"IncludeSelector,omitempty"`
+	UserID          string `xml:"UserID"`
 }
 
 // WithIncludeSelector adds selector options to request
+// Selectors are serialized in the order they were added. Duplicates are ignored.
 func (r *GetUserProfileRequest) WithIncludeSelector(selectors ...IncludeSelectorGUPOption) *GetUserProfileRequest {
-	if len(r.IncludeSelectorMap) == 0 {
-		r.IncludeSelectorMap = make(map[string]struct{})
-	}
 	for _, s := range selectors {
-		r.IncludeSelectorMap[string(s)] = struct{}{}
+		r.IncludeSelector = addToList(r.IncludeSelector, string(s))
 	}
-	var is []string
-	for k := range r.IncludeSelectorMap {
-		is = append(is, k)
+	return r
+}
+
+// WithoutIncludeSelector removes selector options from request
+func (r *GetUserProfileRequest) WithoutIncludeSelector(selectors ...IncludeSelectorGUPOption) *GetUserProfileRequest {
+	for _, s := range selectors {
+		r.IncludeSelector = removeFromList(r.IncludeSelector, string(s))
 	}
-	r.IncludeSelector = strings.Join(is, ",")
+	return r
+}
+
+// ResetIncludeSelector removes all selector options from request
+func (r *GetUserProfileRequest) ResetIncludeSelector() *GetUserProfileRequest {
+	r.IncludeSelector = ""
 	return r
 }
 
@@ -739,24 +876,35 @@ func (r *GetUserProfileRequest) WithUserID(userID string) *GetUserProfileRequest
 
 // Execute executes GetUserProfileRequest
 func (r *GetUserProfileRequest) Execute() (GetUserProfileResponse, error) {
+	return r.ExecuteContext(context.Background())
+}
+
+// ExecuteContext executes GetUserProfileRequest with the given context.
+// The request is aborted when ctx is canceled or its deadline is exceeded.
+// If eBay responds with Ack Failure or PartialFailure, the decoded response is returned with *APIError.
+func (r *GetUserProfileRequest) ExecuteContext(ctx context.Context) (res GetUserProfileResponse, err error) {
+	ctx, span := r.startSpan(ctx)
+	defer func() { span.end(&res, err) }()
+	if err := r.Validate(); err != nil {
+		return GetUserProfileResponse{}, err
+	}
 	body, err := r.getBody()
 	if err != nil {
 		return GetUserProfileResponse{}, fmt.Errorf("unable to serialize req body: %w", err)
 	}
-	// TODO check content type
-	res, err := r.Client.R().SetBody(body).Post(r.URL)
-	if err != nil {
-		return GetUserProfileResponse{}, fmt.Errorf("sending req: %w", err)
-	}
-	if res.StatusCode() != 200 {
-		return GetUserProfileResponse{}, fmt.Errorf("status code %d: %s", res.StatusCode(), res.String())
-	}
 	ar := GetUserProfileResponse{}
-	err = xml.Unmarshal(res.Body(), &ar)
-	if err != nil {
-		return GetUserProfileResponse{}, fmt.Errorf("parsing response body: %w", err)
+	err = r.execute(ctx, body, &ar)
+	if err != nil && !isAPIFailure(err) {
+		return GetUserProfileResponse{}, err
 	}
-	return ar, nil
+	return ar, err
+}
+
+// Validate checks that the request has UserID
+func (r *GetUserProfileRequest) Validate() error {
+	v := validator{}
+	v.required("UserID", r.UserID)
+	return v.err(OperationGetUserProfile)
 }
 
 // GetBody return GetUserProfileRequest body as XML
@@ -769,9 +917,5 @@ func (r *GetUserProfileRequest) GetBody() ([]byte, error) {
 }
 
 func (r *GetUserProfileRequest) getBody() ([]byte, error) {
-	b, err := xml.Marshal(r)
-	if err != nil {
-		return b, err
-	}
-	return append([]byte(xml.Header), b...), err
+	return r.marshal(r)
 }
@@ -1,11 +1,330 @@
 package shopping
 
-import "github.com/go-resty/resty/v2"
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
 
 // RequestBasic is used for requests without pages
+//
+// RequestBasic is bound to the Service which created it and uses the Service's shared HTTP client.
 type RequestBasic struct {
-	URL    string        `xml:"-"`
-	Client *resty.Client `xml:"-"`
+	URL       string `xml:"-"`
+	service   *Service
+	operation EbayOperation
+	siteID    string
+	version   string
+}
+
+// execute sends request body to eBay and decodes response into ar.
+// Every attempt gets IAF token from the service TokenProvider,
+// waits for the service rate limiters and is counted in the service call quota.
+// Failed attempts are retried according to the service RetryPolicy.
+// If ctx is canceled or its deadline is exceeded, the returned error wraps ctx.Err(),
+// so it can be checked with errors.Is(err, context.Canceled) or errors.Is(err, context.DeadlineExceeded).
+//
+// If eBay responds with Ack Failure or PartialFailure, ar is filled and *APIError is returned.
+// For all other errors ar is left empty.
+//
+// If the service has a cache, successful responses are taken from and stored in it.
+// If the service deduplicates requests, concurrent identical requests share one call to eBay.
+func (r *RequestBasic) execute(ctx context.Context, body []byte, ar standardResponse) error {
+	cache := r.service.cache
+	cacheEnabled := cache.enabled(r.operation)
+	var key string
+	if cacheEnabled || r.service.dedup {
+		key = requestKey(r.URL, r.version, r.operation, r.siteID, r.service.encoding, body)
+	}
+	if cacheEnabled {
+		cached, ok := cache.get(r.operation, key)
+		r.service.metrics.ObserveCache(r.operation, ok)
+		if ok {
+			if r.service.unmarshal(cached, ar) == nil {
+				spanFromContext(ctx).setCacheHit()
+				return nil
+			}
+			// broken entry, call eBay
+			cache.cache.Delete(key)
+			resetResponse(ar)
+		}
+	}
+
+	store := func(resBody []byte) {
+		if cacheEnabled {
+			cache.set(r.operation, key, resBody)
+		}
+	}
+	var res sent
+	var err error
+	if r.service.dedup {
+		res, err = r.service.flights.do(ctx, key, func(ctx context.Context) (sent, error) {
+			return r.send(ctx, body, newResponse(ar), store)
+		})
+		// every caller gets its own copy of the response
+		if res.body != nil {
+			if uErr := r.service.unmarshal(res.body, ar); uErr != nil {
+				return fmt.Errorf("parsing response body: %w", uErr)
+			}
+		}
+	} else {
+		res, err = r.send(ctx, body, ar, store)
+	}
+	spanFromContext(ctx).setAttempts(res.attempts)
+	if res.body != nil {
+		ar.standard().Attempts = res.attempts
+	}
+	return err
+}
+
+// sent is a result of send
+type sent struct {
+	// body of the response decoded into ar, nil if ar is left empty
+	body []byte
+	// attempts is the number of attempts made
+	attempts int
+}
+
+// send sends request body to eBay retrying failed attempts and decodes response into ar.
+// Body of successful response is passed to store.
+func (r *RequestBasic) send(ctx context.Context, body []byte, ar standardResponse, store func([]byte)) (sent, error) {
+	policy := r.service.retryPolicy
+	tokenRefreshed := false
+	for attempt := 1; ; attempt++ {
+		provider := r.service.getTokenProvider()
+		token, _, err := provider.Token(ctx)
+		if err != nil {
+			err = fmt.Errorf("getting token: %w", err)
+			if attempt > 1 {
+				return sent{attempts: attempt - 1}, &RetryError{Attempts: attempt - 1, Err: err}
+			}
+			return sent{}, &notSentError{err: err}
+		}
+		if err := r.service.throttle(ctx, r.operation); err != nil {
+			if attempt > 1 {
+				return sent{attempts: attempt - 1}, &RetryError{Attempts: attempt - 1, Err: err}
+			}
+			return sent{}, &notSentError{err: err}
+		}
+		res, resBody, err := r.attempt(ctx, attempt, body, token, ar)
+		if err == nil {
+			store(resBody)
+			return sent{body: resBody, attempts: attempt}, nil
+		}
+		if invalidator, ok := provider.(TokenInvalidator); ok && !tokenRefreshed && IsTokenExpired(err) {
+			// one more call with a new token, it is not counted as an attempt of the retry policy
+			invalidator.InvalidateToken(token)
+			tokenRefreshed = true
+			resetResponse(ar)
+			attempt--
+			continue
+		}
+		if attempt >= policy.MaxAttempts || ctx.Err() != nil || !policy.retryable(res, err) {
+			if attempt > 1 {
+				return sent{body: resBody, attempts: attempt}, &RetryError{Attempts: attempt, Err: err}
+			}
+			return sent{body: resBody, attempts: attempt}, err
+		}
+		wait := policy.backoff(attempt, res)
+		r.service.metrics.ObserveRetry(r.operation)
+		if policy.OnRetry != nil {
+			policy.OnRetry(RetryEvent{
+				Operation: r.operation,
+				Attempt:   attempt,
+				Err:       err,
+				Wait:      wait,
+			})
+		}
+		resetResponse(ar)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return sent{attempts: attempt}, &RetryError{Attempts: attempt, Err: fmt.Errorf("waiting for retry: %w", ctx.Err())}
+		case <-timer.C:
+		}
+	}
+}
+
+// attempt sends request body to eBay once through the service middlewares and decodes response into ar.
+// It returns the response and the body decoded into ar.
+// The response is nil if it was not received, the body is nil if ar is left empty.
+func (r *RequestBasic) attempt(ctx context.Context, attempt int, body []byte, token string, ar standardResponse) (*http.Response, []byte, error) {
+	// TODO check content type
+	call := &Call{
+		Operation: r.operation,
+		SiteID:    r.siteID,
+		Method:    http.MethodPost,
+		URL:       r.URL,
+		Header:    r.header(token),
+		Body:      body,
+		Attempt:   attempt,
+	}
+	if r.service.nameValue {
+		call.Method = http.MethodGet
+	}
+	start := time.Now()
+	result, err := r.service.handler()(ctx, call)
+	observed := CallMetrics{
+		Operation: r.operation,
+		SiteID:    r.siteID,
+		Duration:  time.Since(start),
+	}
+	if result != nil {
+		observed.StatusCode = result.StatusCode
+		observed.Ack = result.Ack
+		for _, e := range result.Errors {
+			observed.ErrorCodes = append(observed.ErrorCodes, e.ErrorCode)
+		}
+	}
+	r.service.metrics.ObserveCall(observed)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, fmt.Errorf("sending req: %w", ctxErr)
+		}
+		return nil, nil, fmt.Errorf("sending req: %w", err)
+	}
+	if result == nil {
+		return nil, nil, errors.New("sending req: middleware returned neither result nor error")
+	}
+	res := result.Response
+	if res == nil {
+		// created by a middleware
+		res = &http.Response{StatusCode: result.StatusCode, Header: result.Header}
+	}
+	if result.StatusCode != http.StatusOK {
+		apiErr := &APIError{
+			Operation:  r.operation,
+			StatusCode: result.StatusCode,
+		}
+		rs := responseStandard{}
+		if r.service.unmarshalEnvelope(result.Body, &rs) == nil && len(rs.Errors) > 0 {
+			apiErr.Ack = rs.Ack
+			apiErr.CorrelationID = rs.CorrelationID
+			apiErr.Errors = rs.Errors
+		} else {
+			apiErr.Body = string(result.Body)
+		}
+		return res, nil, apiErr
+	}
+	err = r.service.unmarshal(result.Body, ar)
+	if err != nil {
+		resetResponse(ar)
+		return res, nil, fmt.Errorf("parsing response body: %w", err)
+	}
+	return res, result.Body, ar.standard().apiError(r.operation, result.StatusCode)
+}
+
+// marshal encodes request v according to the service encoding.
+// In name-value mode it returns URL query.
+func (r *RequestBasic) marshal(v interface{}) ([]byte, error) {
+	if r.service.nameValue {
+		values, err := marshalNV(v)
+		if err != nil {
+			return nil, err
+		}
+		values.Set("callname", string(r.operation))
+		values.Set("version", r.version)
+		values.Set("siteid", r.siteID)
+		values.Set("responseencoding", string(r.service.encoding))
+		return []byte(values.Encode()), nil
+	}
+	if r.service.encoding == EncodingJSON {
+		return marshalJSON(v)
+	}
+	b, err := xml.Marshal(v)
+	if err != nil {
+		return b, err
+	}
+	return append([]byte(xml.Header), b...), err
+}
+
+// newResponse returns pointer to new zero response of the same type as ar
+func newResponse(ar standardResponse) standardResponse {
+	return reflect.New(reflect.TypeOf(ar).Elem()).Interface().(standardResponse)
+}
+
+// header returns headers of a call with the token
+func (r *RequestBasic) header(token string) http.Header {
+	h := http.Header{}
+	h.Set("X-EBAY-API-VERSION", r.version)
+	h.Set("X-EBAY-API-CALL-NAME", string(r.operation))
+	h.Set("X-EBAY-API-SITE-ID", r.siteID)
+	h.Set("X-EBAY-API-IAF-TOKEN", token)
+	h.Set("X-EBAY-API-REQUEST-ENCODING", string(r.service.encoding))
+	h.Set("X-EBAY-API-RESPONSE-ENCODING", string(r.service.encoding))
+	return h
+}
+
+// resetResponse sets response pointed by ar to its zero value
+func resetResponse(ar standardResponse) {
+	v := reflect.ValueOf(ar).Elem()
+	v.Set(reflect.Zero(v.Type()))
+}
+
+// standardResponse is implemented by all responses (using embedded responseStandard)
+type standardResponse interface {
+	standard() *responseStandard
+}
+
+/*
+==============================================================
+*/
+
+// appendUnique appends values which are not in list yet.
+// The order of values is preserved, empty values are ignored.
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		if v == "" || containsString(list, v) {
+			continue
+		}
+		list = append(list, v)
+	}
+	return list
+}
+
+// removeValues removes values from list preserving the order of other elements
+func removeValues(list []string, values ...string) []string {
+	var rest []string
+	for _, v := range list {
+		if !containsString(values, v) {
+			rest = append(rest, v)
+		}
+	}
+	return rest
+}
+
+// containsString checks if list contains value
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// addToList adds value to comma-separated list (e.g. IncludeSelector) if it is not there yet
+func addToList(list string, value string) string {
+	return strings.Join(appendUnique(splitList(list), value), ",")
+}
+
+// removeFromList removes value from comma-separated list (e.g. IncludeSelector)
+func removeFromList(list string, value string) string {
+	return strings.Join(removeValues(splitList(list), value), ",")
+}
+
+// splitList splits comma-separated list
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+	return strings.Split(list, ",")
 }
 
 /*
@@ -0,0 +1,70 @@
+package shopping
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testTimeResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<GeteBayTimeResponse xmlns="urn:ebay:apis:eBLBaseComponents">
+  <Timestamp>2021-11-27T00:28:30.123Z</Timestamp>
+  <Ack>Success</Ack>
+  <Build>E1199_CORE_APILW2_19110890_R1</Build>
+  <Version>1199</Version>
+</GeteBayTimeResponse>`
+
+func TestGeteBayTimeRequest_ExecuteContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, string(OperationGeteBayTime), r.Header.Get("X-EBAY-API-CALL-NAME"))
+		_, _ = w.Write([]byte(testTimeResponse))
+	}))
+	defer server.Close()
+
+	service := NewService("").WithEndpoint(server.URL)
+	res, err := service.NewGeteBayTimeRequest().ExecuteContext(context.Background())
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Success", res.Ack)
+	assert.Equal(t, "2021-11-27T00:28:30.123Z", res.Timestamp.Raw)
+	assert.Equal(t, time.Date(2021, 11, 27, 0, 28, 30, 123000000, UTC), res.Timestamp.Time)
+}
+
+func TestRequestBasic_ExecuteContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	service := NewService("").WithEndpoint(server.URL)
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			cancel()
+		}()
+		_, err := service.NewGetSingleItemRequest().WithItemID("1").ExecuteContext(ctx)
+		assert.True(t, errors.Is(err, context.Canceled), "got %v", err)
+		assert.False(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := service.NewFindProductsRequest().WithQueryKeywords("Harry Potter").GetPageContext(ctx, 2)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+		assert.False(t, errors.Is(err, context.Canceled))
+	})
+}
@@ -497,3 +497,82 @@ func TestGetUserProfileRequest_GetBody(t *testing.T) {
 
 	}
 }
+
+func TestRequest_DeterministicOrder(t *testing.T) {
+	service := NewService("")
+
+	multiple := service.NewGetMultipleItemsRequest().
+		WithIncludeSelector(IncludeSelectorMIVariations, IncludeSelectorMIDetails, IncludeSelectorMIItemSpecifics).
+		WithItemID("3", "1", "2", "1")
+	want, err := multiple.GetBody()
+	if !assert.NoError(t, err) {
+		return
+	}
+	for i := 0; i < 20; i++ {
+		req := service.NewGetMultipleItemsRequest().
+			WithIncludeSelector(IncludeSelectorMIVariations, IncludeSelectorMIDetails, IncludeSelectorMIItemSpecifics).
+			WithItemID("3", "1", "2", "1")
+		got, err := req.GetBody()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, string(want), string(got))
+	}
+	assert.Equal(t, "Variations,Details,ItemSpecifics", multiple.IncludeSelector)
+	assert.Equal(t, []string{"3", "1", "2"}, multiple.ItemIDs)
+
+	multiple.WithoutIncludeSelector(IncludeSelectorMIDetails).WithoutItemID("1")
+	assert.Equal(t, "Variations,ItemSpecifics", multiple.IncludeSelector)
+	assert.Equal(t, []string{"3", "2"}, multiple.ItemIDs)
+	multiple.ResetIncludeSelector().ResetItemIDs()
+	assert.Empty(t, multiple.IncludeSelector)
+	assert.Empty(t, multiple.ItemIDs)
+
+	status := service.NewGetItemStatusRequest().WithItemID("b", "a", "c", "a")
+	assert.Equal(t, []string{"b", "a", "c"}, status.ItemIDs)
+	status.WithoutItemID("a", "x")
+	assert.Equal(t, []string{"b", "c"}, status.ItemIDs)
+	status.ResetItemIDs().WithItemID("d")
+	assert.Equal(t, []string{"d"}, status.ItemIDs)
+
+	profile := service.NewGetUserProfileRequest().
+		WithIncludeSelector(IncludeSelectorUPFeedbackHistory, IncludeSelectorUPDetails, IncludeSelectorUPFeedbackHistory)
+	assert.Equal(t, "FeedbackHistory,Details", profile.IncludeSelector)
+	single := service.NewGetSingleItemRequest().
+		WithIncludeSelector(IncludeSelectorSITextVariations, IncludeSelectorSIDetails).
+		WithoutIncludeSelector(IncludeSelectorSITextVariations)
+	assert.Equal(t, "Details", single.IncludeSelector)
+	category := service.NewGetCategoryInfoRequest().WithIncludeSelector(IncludeSelectorChildCategories)
+	assert.Equal(t, "ChildCategories", category.IncludeSelector)
+	category.ResetIncludeSelector()
+	assert.Empty(t, category.IncludeSelector)
+}
+
+func TestGetShippingCostsRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		country CountryCode
+		postal  string
+		wantErr bool
+	}{
+		{name: "no destination"},
+		{name: "GB", country: CountryCodeGB},
+		{name: "US with postal code", country: CountryCodeUS, postal: "95125"},
+		{name: "US without postal code", country: CountryCodeUS, wantErr: true},
+		{name: "UK", country: "UK", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := NewService("").NewGetShippingCostsRequest().WithItemID("1").
+				WithDestinationCountryCode(tt.country).WithDestinationPostalCode(tt.postal)
+			err := req.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				_, err = req.Execute()
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -3,12 +3,12 @@ package shopping
 // FindProductsResponse is response for FindProductsRequest
 type FindProductsResponse struct {
 	responseStandard
-	ApproximatePages int `xml:"ApproximatePages"`
-	//DomainHistogram DomainHistogram `xml:"DomainHistogram"`
-	MoreResults   bool      `xml:"MoreResults"`
-	PageNumber    int       `xml:"PageNumber"`
-	TotalProducts int       `xml:"TotalProducts"`
-	Products      []Product `xml:"Product"`
+	ApproximatePages int `xml:"ApproximatePages" json:"ApproximatePages"`
+	//DomainHistogram DomainHistogram `xml:"DomainHistogram" json:"DomainHistogram"`
+	MoreResults   bool      `xml:"MoreResults" json:"MoreResults"`
+	PageNumber    int       `xml:"PageNumber" json:"PageNumber"`
+	TotalProducts int       `xml:"TotalProducts" json:"TotalProducts"`
+	Products      []Product `xml:"Product" json:"Product"`
 }
 
 // Product is returned for each eBay catalog product that matches the search criteria.
@@ -16,23 +16,23 @@ type FindProductsResponse struct {
 // product identifiers (ePID and any GTIN value(s)), product aspects, a link to eBay product page,
 // and links to stock photos (if any).
 type Product struct {
-	DetailsURL         string          `xml:"DetailsURL"`
-	DisplayStockPhotos bool            `xml:"DisplayStockPhotos"`
-	DomainName         string          `xml:"DomainName"`
-	ItemSpecifics      []NameValueList `xml:"ItemSpecifics>NameValueList"`
-	ProductIDs         []ProductID     `xml:"ProductID"`
-	ProductState       string          `xml:"ProductState"`
-	ReviewCount        int             `xml:"ReviewCount"`
-	StockPhotoURL      string          `xml:"StockPhotoURL"`
-	Title              string          `xml:"Title"`
+	DetailsURL         string          `xml:"DetailsURL" json:"DetailsURL"`
+	DisplayStockPhotos bool            `xml:"DisplayStockPhotos" json:"DisplayStockPhotos"`
+	DomainName         string          `xml:"DomainName" json:"DomainName"`
+	ItemSpecifics      []NameValueList `xml:"ItemSpecifics>NameValueList" json:"-"`
+	ProductIDs         []ProductID     `xml:"ProductID" json:"ProductID"`
+	ProductState       string          `xml:"ProductState" json:"ProductState"`
+	ReviewCount        int             `xml:"ReviewCount" json:"ReviewCount"`
+	StockPhotoURL      string          `xml:"StockPhotoURL" json:"StockPhotoURL"`
+	Title              string          `xml:"Title" json:"Title"`
 }
 
 // NameValueList is an array of StatusItem Specifics name-value pairs for an eBay Catalog product (if FindProducts is used)
 // or StatusItem Specifics name-value pairs for a single-variation listing or individual variation within
 // a multiple-variation listing (if GetSingleItem or GetMultipleItems is used).
 type NameValueList struct {
-	Name   string   `xml:"Name"`
-	Values []string `xml:"Value"`
+	Name   string   `xml:"Name" json:"Name"`
+	Values []string `xml:"Value" json:"Value"`
 }
 
 /*
@@ -42,30 +42,30 @@ type NameValueList struct {
 // GetCategoryInfoResponse represents response for GetCategoryInfoRequest
 type GetCategoryInfoResponse struct {
 	responseStandard
-	CategoryArray   []Category `xml:"CategoryArray"`
-	CategoryCount   int        `xml:"CategoryCount"`
-	CategoryVersion string     `xml:"CategoryVersion"`
-	UpdateTime      string     `xml:"UpdateTime"`
+	CategoryArray   []Category `xml:"CategoryArray" json:"CategoryArray"`
+	CategoryCount   int        `xml:"CategoryCount" json:"CategoryCount"`
+	CategoryVersion string     `xml:"CategoryVersion" json:"CategoryVersion"`
+	UpdateTime      EbayTime   `xml:"UpdateTime" json:"UpdateTime"`
 }
 
 // Category consists of high-level details of a category, including its category ID value, full category path
 // (by name and by category ID), its level in the eBay site's category hierarchy, category ID
 // of its parent category, and a boolean value to indicate if it is a listing (leaf) category.
 type Category struct {
-	CategoryID       string `xml:"CategoryID"`
-	CategoryIDPath   string `xml:"CategoryIDPath"`
-	CategoryLevel    int    `xml:"CategoryLevel"`
-	CategoryName     string `xml:"CategoryName"`
-	CategoryNamePath string `xml:"CategoryNamePath"`
-	CategoryParentID string `xml:"CategoryParentID"`
-	LeafCategory     bool   `xml:"LeafCategory"`
+	CategoryID       string `xml:"CategoryID" json:"CategoryID"`
+	CategoryIDPath   string `xml:"CategoryIDPath" json:"CategoryIDPath"`
+	CategoryLevel    int    `xml:"CategoryLevel" json:"CategoryLevel"`
+	CategoryName     string `xml:"CategoryName" json:"CategoryName"`
+	CategoryNamePath string `xml:"CategoryNamePath" json:"CategoryNamePath"`
+	CategoryParentID string `xml:"CategoryParentID" json:"CategoryParentID"`
+	LeafCategory     bool   `xml:"LeafCategory" json:"LeafCategory"`
 }
 
 /*
 ===========================================================
 */
 
-//GeteBayTimeResponse is a response for GeteBayTimeRequest
+// GeteBayTimeResponse is a response for GeteBayTimeRequest
 type GeteBayTimeResponse struct {
 	responseStandard
 }
@@ -77,35 +77,29 @@ type GeteBayTimeResponse struct {
 // GetItemStatusResponse is a response for GetItemStatusRequest
 type GetItemStatusResponse struct {
 	responseStandard
-	Items []StatusItem `xml:"StatusItem"`
+	Items []StatusItem `xml:"StatusItem" json:"StatusItem"`
 }
 
 // StatusItem is returned for each ItemID value that was specified in the call request.
 // One GetItemStatus call can retrieve up to 20 eBay listings.
 type StatusItem struct {
-	BidCount              int       `xml:"BidCount"`
-	ConvertedCurrentPrice Price     `xml:"ConvertedCurrentPrice"`
-	EndTime               string    `xml:"EndTime"`
-	HighBidder            BasicUser `xml:"HighBidder"`
-	ItemID                string    `xml:"ItemID"`
-	ListingStatus         string    `xml:"ListingStatus"`
-	TimeLeft              string    `xml:"TimeLeft"`
-	ReserveMet            bool      `xml:"ReserveMet"`
-	BuyItNowAvailable     bool      `xml:"BuyItNowAvailable"`
-}
-
-// Price ...
-type Price struct {
-	CurrencyID string  `xml:"currencyID,attr"`
-	Value      float64 `xml:",cdata"`
+	BidCount              int          `xml:"BidCount" json:"BidCount"`
+	ConvertedCurrentPrice Price        `xml:"ConvertedCurrentPrice" json:"ConvertedCurrentPrice"`
+	EndTime               EbayTime     `xml:"EndTime" json:"EndTime"`
+	HighBidder            BasicUser    `xml:"HighBidder" json:"HighBidder"`
+	ItemID                string       `xml:"ItemID" json:"ItemID"`
+	ListingStatus         string       `xml:"ListingStatus" json:"ListingStatus"`
+	TimeLeft              EbayDuration `xml:"TimeLeft" json:"TimeLeft"`
+	ReserveMet            bool         `xml:"ReserveMet" json:"ReserveMet"`
+	BuyItNowAvailable     bool         `xml:"BuyItNowAvailable" json:"BuyItNowAvailable"`
 }
 
 // BasicUser is used to express the details for one eBay user.
 type BasicUser struct {
-	FeedbackPrivate    bool   `xml:"FeedbackPrivate"`
-	FeedbackRatingStar string `xml:"FeedbackRatingStar"`
-	FeedbackScore      int    `xml:"FeedbackScore"`
-	UserID             string `xml:"UserID"`
+	FeedbackPrivate    bool   `xml:"FeedbackPrivate" json:"FeedbackPrivate"`
+	FeedbackRatingStar string `xml:"FeedbackRatingStar" json:"FeedbackRatingStar"`
+	FeedbackScore      int    `xml:"FeedbackScore" json:"FeedbackScore"`
+	UserID             string `xml:"UserID" json:"UserID"`
 }
 
 /*
@@ -115,120 +109,120 @@ type BasicUser struct {
 // GetMultipleItemsResponse is a response for GetMultipleItemsRequest
 type GetMultipleItemsResponse struct {
 	responseStandard
-	Items []Item `xml:"Item"`
+	Items []Item `xml:"Item" json:"Item"`
 }
 
 // Item contains details about the listing whose ID was specified in the request.
 type Item struct {
-	AutoPay                             bool                    `xml:"AutoPay"`
-	AvailableForPickupDropOff           bool                    `xml:"AvailableForPickupDropOff"`
-	BestOfferEnabled                    bool                    `xml:"BestOfferEnabled"`
-	BuyItNowAvailable                   bool                    `xml:"BuyItNowAvailable"`
-	EligibleForPickupDropOff            bool                    `xml:"EligibleForPickupDropOff"`
-	GlobalShipping                      bool                    `xml:"GlobalShipping"`
-	IgnoreQuantity                      bool                    `xml:"IgnoreQuantity"`
-	IntegratedMerchantCreditCardEnabled bool                    `xml:"IntegratedMerchantCreditCardEnabled"`
-	BidCount                            int                     `xml:"BidCount"`
-	BusinessSellerDetails               BusinessSellerDetails   `xml:"BusinessSellerDetails"`
-	BuyItNowPrice                       Price                   `xml:"BuyItNowPrice"`
-	Charity                             Charity                 `xml:"Charity"`
-	ConditionDescription                string                  `xml:"ConditionDescription"`
-	ConditionDisplayName                string                  `xml:"ConditionDisplayName"`
-	ConditionID                         int                     `xml:"ConditionID"`
-	ConvertedBuyItNowPrice              Price                   `xml:"ConvertedBuyItNowPrice"`
-	ConvertedCurrentPrice               Price                   `xml:"ConvertedCurrentPrice"`
-	Country                             string                  `xml:"Country"`
-	CurrentPrice                        Price                   `xml:"CurrentPrice"`
-	Description                         string                  `xml:"Description"`
-	DiscountPriceInfo                   DiscountPriceInfo       `xml:"DiscountPriceInfo"`
-	EndTime                             string                  `xml:"EndTime"`
-	ExcludeShipToLocations              []string                `xml:"ExcludeShipToLocation"`
-	GalleryURL                          string                  `xml:"GalleryURL"`
-	HandlingTime                        int                     `xml:"HandlingTime"`
-	HighBidder                          User                    `xml:"HighBidder"`
-	HitCount                            int64                   `xml:"HitCount"`
-	ItemID                              string                  `xml:"ItemID"`
-	ItemSpecifics                       []NameValueList         `xml:"ItemSpecifics>NameValueList"`
-	ListingStatus                       string                  `xml:"ListingStatus"`
-	ListingType                         string                  `xml:"ListingType"`
-	Location                            string                  `xml:"Location"`
-	LotSize                             int                     `xml:"LotSize"`
-	MinimumToBid                        Price                   `xml:"MinimumToBid"`
-	PaymentAllowedSites                 []string                `xml:"PaymentAllowedSite"`
-	PaymentMethods                      []string                `xml:"PaymentMethods"`
-	PictureURLs                         []string                `xml:"PictureURL"`
-	PostalCode                          string                  `xml:"PostalCode"`
-	PrimaryCategoryID                   string                  `xml:"PrimaryCategoryID"`
-	PrimaryCategoryIDPath               string                  `xml:"PrimaryCategoryIDPath"`
-	PrimaryCategoryName                 string                  `xml:"PrimaryCategoryName"`
-	ProductID                           string                  `xml:"ProductID"`
-	Quantity                            int                     `xml:"Quantity"`
-	QuantityAvailableHint               string                  `xml:"QuantityAvailableHint"`
-	MinimumRemnantSet                   int                     `xml:"QuantityInfo>MinimumRemnantSet"`
-	QuantitySold                        int                     `xml:"QuantitySold"`
-	QuantitySoldByPickupInStore         int                     `xml:"QuantitySoldByPickupInStore"`
-	QuantityThreshold                   int                     `xml:"QuantityThreshold"`
-	ReturnPolicy                        ReturnPolicy            `xml:"ReturnPolicy"`
-	SecondaryCategoryID                 string                  `xml:"SecondaryCategoryID"`
-	SecondaryCategoryIDPath             string                  `xml:"SecondaryCategoryIDPath"`
-	SecondaryCategoryName               string                  `xml:"SecondaryCategoryName"`
-	Seller                              Seller                  `xml:"Seller"`
-	ShippingCostSummary                 ItemShippingCostSummary `xml:"ShippingCostSummary"`
-	ShipToLocations                     []string                `xml:"ShipToLocations"`
-	Site                                string                  `xml:"Site"`
-	SKU                                 string                  `xml:"SKU"`
-	StartTime                           string                  `xml:"StartTime"`
-	Storefront                          Storefront              `xml:"Storefront"`
-	Subtitle                            string                  `xml:"Subtitle"`
-	TimeLeft                            string                  `xml:"TimeLeft"`
-	Title                               string                  `xml:"Title"`
-	ReserveMet                          bool                    `xml:"ReserveMet"`
-	TopRatedListing                     bool                    `xml:"TopRatedListing"`
-	UnitInfo                            UnitInfo                `xml:"UnitInfo"`
-	Variations                          Variations              `xml:"Variations"`
-	VhrAvailable                        string                  `xml:"VhrAvailable"`
-	VhrUrl                              string                  `xml:"VhrUrl"`
-	ViewItemURLForNaturalSearch         string                  `xml:"ViewItemURLForNaturalSearch"`
+	AutoPay                             bool                    `xml:"AutoPay" json:"AutoPay"`
+	AvailableForPickupDropOff           bool                    `xml:"AvailableForPickupDropOff" json:"AvailableForPickupDropOff"`
+	BestOfferEnabled                    bool                    `xml:"BestOfferEnabled" json:"BestOfferEnabled"`
+	BuyItNowAvailable                   bool                    `xml:"BuyItNowAvailable" json:"BuyItNowAvailable"`
+	EligibleForPickupDropOff            bool                    `xml:"EligibleForPickupDropOff" json:"EligibleForPickupDropOff"`
+	GlobalShipping                      bool                    `xml:"GlobalShipping" json:"GlobalShipping"`
+	IgnoreQuantity                      bool                    `xml:"IgnoreQuantity" json:"IgnoreQuantity"`
+	IntegratedMerchantCreditCardEnabled bool                    `xml:"IntegratedMerchantCreditCardEnabled" json:"IntegratedMerchantCreditCardEnabled"`
+	BidCount                            int                     `xml:"BidCount" json:"BidCount"`
+	BusinessSellerDetails               BusinessSellerDetails   `xml:"BusinessSellerDetails" json:"BusinessSellerDetails"`
+	BuyItNowPrice                       Price                   `xml:"BuyItNowPrice" json:"BuyItNowPrice"`
+	Charity                             Charity                 `xml:"Charity" json:"Charity"`
+	ConditionDescription                string                  `xml:"ConditionDescription" json:"ConditionDescription"`
+	ConditionDisplayName                string                  `xml:"ConditionDisplayName" json:"ConditionDisplayName"`
+	ConditionID                         int                     `xml:"ConditionID" json:"ConditionID"`
+	ConvertedBuyItNowPrice              Price                   `xml:"ConvertedBuyItNowPrice" json:"ConvertedBuyItNowPrice"`
+	ConvertedCurrentPrice               Price                   `xml:"ConvertedCurrentPrice" json:"ConvertedCurrentPrice"`
+	Country                             string                  `xml:"Country" json:"Country"`
+	CurrentPrice                        Price                   `xml:"CurrentPrice" json:"CurrentPrice"`
+	Description                         string                  `xml:"Description" json:"Description"`
+	DiscountPriceInfo                   DiscountPriceInfo       `xml:"DiscountPriceInfo" json:"DiscountPriceInfo"`
+	EndTime                             EbayTime                `xml:"EndTime" json:"EndTime"`
+	ExcludeShipToLocations              []string                `xml:"ExcludeShipToLocation" json:"ExcludeShipToLocation"`
+	GalleryURL                          string                  `xml:"GalleryURL" json:"GalleryURL"`
+	HandlingTime                        int                     `xml:"HandlingTime" json:"HandlingTime"`
+	HighBidder                          User                    `xml:"HighBidder" json:"HighBidder"`
+	HitCount                            int64                   `xml:"HitCount" json:"HitCount"`
+	ItemID                              string                  `xml:"ItemID" json:"ItemID"`
+	ItemSpecifics                       []NameValueList         `xml:"ItemSpecifics>NameValueList" json:"-"`
+	ListingStatus                       string                  `xml:"ListingStatus" json:"ListingStatus"`
+	ListingType                         string                  `xml:"ListingType" json:"ListingType"`
+	Location                            string                  `xml:"Location" json:"Location"`
+	LotSize                             int                     `xml:"LotSize" json:"LotSize"`
+	MinimumToBid                        Price                   `xml:"MinimumToBid" json:"MinimumToBid"`
+	PaymentAllowedSites                 []string                `xml:"PaymentAllowedSite" json:"PaymentAllowedSite"`
+	PaymentMethods                      []string                `xml:"PaymentMethods" json:"PaymentMethods"`
+	PictureURLs                         []string                `xml:"PictureURL" json:"PictureURL"`
+	PostalCode                          string                  `xml:"PostalCode" json:"PostalCode"`
+	PrimaryCategoryID                   string                  `xml:"PrimaryCategoryID" json:"PrimaryCategoryID"`
+	PrimaryCategoryIDPath               string                  `xml:"PrimaryCategoryIDPath" json:"PrimaryCategoryIDPath"`
+	PrimaryCategoryName                 string                  `xml:"PrimaryCategoryName" json:"PrimaryCategoryName"`
+	ProductID                           string                  `xml:"ProductID" json:"ProductID"`
+	Quantity                            int                     `xml:"Quantity" json:"Quantity"`
+	QuantityAvailableHint               string                  `xml:"QuantityAvailableHint" json:"QuantityAvailableHint"`
+	MinimumRemnantSet                   int                     `xml:"QuantityInfo>MinimumRemnantSet" json:"-"`
+	QuantitySold                        int                     `xml:"QuantitySold" json:"QuantitySold"`
+	QuantitySoldByPickupInStore         int                     `xml:"QuantitySoldByPickupInStore" json:"QuantitySoldByPickupInStore"`
+	QuantityThreshold                   int                     `xml:"QuantityThreshold" json:"QuantityThreshold"`
+	ReturnPolicy                        ReturnPolicy            `xml:"ReturnPolicy" json:"ReturnPolicy"`
+	SecondaryCategoryID                 string                  `xml:"SecondaryCategoryID" json:"SecondaryCategoryID"`
+	SecondaryCategoryIDPath             string                  `xml:"SecondaryCategoryIDPath" json:"SecondaryCategoryIDPath"`
+	SecondaryCategoryName               string                  `xml:"SecondaryCategoryName" json:"SecondaryCategoryName"`
+	Seller                              Seller                  `xml:"Seller" json:"Seller"`
+	ShippingCostSummary                 ItemShippingCostSummary `xml:"ShippingCostSummary" json:"ShippingCostSummary"`
+	ShipToLocations                     []string                `xml:"ShipToLocations" json:"ShipToLocations"`
+	Site                                string                  `xml:"Site" json:"Site"`
+	SKU                                 string                  `xml:"SKU" json:"SKU"`
+	StartTime                           EbayTime                `xml:"StartTime" json:"StartTime"`
+	Storefront                          Storefront              `xml:"Storefront" json:"Storefront"`
+	Subtitle                            string                  `xml:"Subtitle" json:"Subtitle"`
+	TimeLeft                            EbayDuration            `xml:"TimeLeft" json:"TimeLeft"`
+	Title                               string                  `xml:"Title" json:"Title"`
+	ReserveMet                          bool                    `xml:"ReserveMet" json:"ReserveMet"`
+	TopRatedListing                     bool                    `xml:"TopRatedListing" json:"TopRatedListing"`
+	UnitInfo                            UnitInfo                `xml:"UnitInfo" json:"UnitInfo"`
+	Variations                          Variations              `xml:"Variations" json:"Variations"`
+	VhrAvailable                        string                  `xml:"VhrAvailable" json:"VhrAvailable"`
+	VhrUrl                              string                  `xml:"VhrUrl" json:"VhrUrl"`
+	ViewItemURLForNaturalSearch         string                  `xml:"ViewItemURLForNaturalSearch" json:"ViewItemURLForNaturalSearch"`
 }
 
 // BusinessSellerDetails  is returned if the seller of the item is registered on the eBay listing site as a
 // Business Seller. This container consists of information related to the Business Seller's account.
 // Not all eBay sites support Business Sellers.
 type BusinessSellerDetails struct {
-	AdditionalContactInformation string     `xml:"AdditionalContactInformation"`
-	Address                      Address    `xml:"Address"`
-	Email                        string     `xml:"Email"`
-	Fax                          string     `xml:"Fax"`
-	LegalInvoice                 bool       `xml:"LegalInvoice"`
-	TermsAndConditions           string     `xml:"TermsAndConditions"`
-	TradeRegistrationNumber      string     `xml:"TradeRegistrationNumber"`
-	VATDetails                   VATDetails `xml:"VATDetails"`
+	AdditionalContactInformation string     `xml:"AdditionalContactInformation" json:"AdditionalContactInformation"`
+	Address                      Address    `xml:"Address" json:"Address"`
+	Email                        string     `xml:"Email" json:"Email"`
+	Fax                          string     `xml:"Fax" json:"Fax"`
+	LegalInvoice                 bool       `xml:"LegalInvoice" json:"LegalInvoice"`
+	TermsAndConditions           string     `xml:"TermsAndConditions" json:"TermsAndConditions"`
+	TradeRegistrationNumber      string     `xml:"TradeRegistrationNumber" json:"TradeRegistrationNumber"`
+	VATDetails                   VATDetails `xml:"VATDetails" json:"VATDetails"`
 }
 
 // Address is used to provide details about a Business Seller's address.
 type Address struct {
-	CityName        string `xml:"CityName"`
-	CompanyName     string `xml:"CompanyName"`
-	CountryName     string `xml:"CountryName"`
-	FirstName       string `xml:"FirstName"`
-	LastName        string `xml:"LastName"`
-	Name            string `xml:"Name"`
-	Phone           string `xml:"Phone"`
-	PostalCode      string `xml:"PostalCode"`
-	StateOrProvince string `xml:"StateOrProvince"`
-	Street1         string `xml:"Street1"`
-	Street2         string `xml:"Street2"`
+	CityName        string `xml:"CityName" json:"CityName"`
+	CompanyName     string `xml:"CompanyName" json:"CompanyName"`
+	CountryName     string `xml:"CountryName" json:"CountryName"`
+	FirstName       string `xml:"FirstName" json:"FirstName"`
+	LastName        string `xml:"LastName" json:"LastName"`
+	Name            string `xml:"Name" json:"Name"`
+	Phone           string `xml:"Phone" json:"Phone"`
+	PostalCode      string `xml:"PostalCode" json:"PostalCode"`
+	StateOrProvince string `xml:"StateOrProvince" json:"StateOrProvince"`
+	Street1         string `xml:"Street1" json:"Street1"`
+	Street2         string `xml:"Street2" json:"Street2"`
 }
 
 // VATDetails provides Value-Added Tax (VAT) details for the Business Seller, including the seller's VAT ID
 // and the VAT percentage rate applicable to the item. VAT is similar to a sales and/or consumption tax,
 // and it is only applicable to sellers selling on European sites.
 type VATDetails struct {
-	BusinessSeller       bool    `xml:"BusinessSeller"`
-	RestrictedToBusiness bool    `xml:"RestrictedToBusiness"`
-	VATID                string  `xml:"VATID"`
-	VATPercent           float64 `xml:"VATPercent"`
-	VATSite              string  `xml:"VATSite"`
+	BusinessSeller       bool    `xml:"BusinessSeller" json:"BusinessSeller"`
+	RestrictedToBusiness bool    `xml:"RestrictedToBusiness" json:"RestrictedToBusiness"`
+	VATID                string  `xml:"VATID" json:"VATID"`
+	VATPercent           float64 `xml:"VATPercent" json:"VATPercent"`
+	VATSite              string  `xml:"VATSite" json:"VATSite"`
 }
 
 // Charity is returned if any percentage of the sales proceeds is going to a nonprofit organization that is
@@ -236,13 +230,13 @@ type VATDetails struct {
 // organization, including the name, mission, and unique identifier of the charity, as well as the percentage
 // rate of the sale proceeds that will go to the charity for each sale.
 type Charity struct {
-	CharityID       string  `xml:"CharityID"`
-	CharityName     string  `xml:"CharityName"`
-	CharityNumber   int     `xml:"CharityNumber"`
-	DonationPercent float64 `xml:"DonationPercent"`
-	LogoURL         string  `xml:"LogoURL"`
-	Mission         string  `xml:"Mission"`
-	Status          string  `xml:"Status"`
+	CharityID       string  `xml:"CharityID" json:"CharityID"`
+	CharityName     string  `xml:"CharityName" json:"CharityName"`
+	CharityNumber   int     `xml:"CharityNumber" json:"CharityNumber"`
+	DonationPercent float64 `xml:"DonationPercent" json:"DonationPercent"`
+	LogoURL         string  `xml:"LogoURL" json:"LogoURL"`
+	Mission         string  `xml:"Mission" json:"Mission"`
+	Status          string  `xml:"Status" json:"Status"`
 }
 
 // DiscountPriceInfo provides information for an item that has a Strikethrough Price (STP)
@@ -250,18 +244,18 @@ type Charity struct {
 // STP is available on the US, eBay Motors, UK, Germany, Canada (English and French), France,
 // Italy, and Spain sites, while MAP is available only on the US site.
 type DiscountPriceInfo struct {
-	MinimumAdvertisedPrice         Price  `xml:"MinimumAdvertisedPrice"`
-	MinimumAdvertisedPriceExposure string `xml:"MinimumAdvertisedPriceExposure"`
-	OriginalRetailPrice            Price  `xml:"OriginalRetailPrice"`
-	PricingTreatment               string `xml:"PricingTreatment"`
-	SoldOffeBay                    bool   `xml:"SoldOffeBay"`
-	SoldOneBay                     bool   `xml:"SoldOneBay"`
+	MinimumAdvertisedPrice         Price  `xml:"MinimumAdvertisedPrice" json:"MinimumAdvertisedPrice"`
+	MinimumAdvertisedPriceExposure string `xml:"MinimumAdvertisedPriceExposure" json:"MinimumAdvertisedPriceExposure"`
+	OriginalRetailPrice            Price  `xml:"OriginalRetailPrice" json:"OriginalRetailPrice"`
+	PricingTreatment               string `xml:"PricingTreatment" json:"PricingTreatment"`
+	SoldOffeBay                    bool   `xml:"SoldOffeBay" json:"SoldOffeBay"`
+	SoldOneBay                     bool   `xml:"SoldOneBay" json:"SoldOneBay"`
 }
 
 // User ...
 type User struct {
 	BasicUser
-	UserAnonymized bool `xml:"UserAnonymized"`
+	UserAnonymized bool `xml:"UserAnonymized" json:"UserAnonymized"`
 }
 
 // ReturnPolicy consists of details related to the seller's Return Policy, both for domestic and international
@@ -269,39 +263,39 @@ type User struct {
 // ReturnsAccepted field (or InternationalReturnsAccepted field for international buyers) is
 // returned with a value of ReturnsNotAccepted.
 type ReturnPolicy struct {
-	Description                     string `xml:"Description"`
-	InternationalRefund             string `xml:"InternationalRefund"`
-	InternationalReturnsAccepted    string `xml:"InternationalReturnsAccepted"`
-	InternationalReturnsWithin      string `xml:"InternationalReturnsWithin"`
-	InternationalShippingCostPaidBy string `xml:"InternationalShippingCostPaidBy"`
-	Refund                          string `xml:"Refund"`
-	ReturnsAccepted                 string `xml:"ReturnsAccepted"`
-	ReturnsWithin                   string `xml:"ReturnsWithin"`
-	ShippingCostPaidBy              string `xml:"ShippingCostPaidBy"`
+	Description                     string `xml:"Description" json:"Description"`
+	InternationalRefund             string `xml:"InternationalRefund" json:"InternationalRefund"`
+	InternationalReturnsAccepted    string `xml:"InternationalReturnsAccepted" json:"InternationalReturnsAccepted"`
+	InternationalReturnsWithin      string `xml:"InternationalReturnsWithin" json:"InternationalReturnsWithin"`
+	InternationalShippingCostPaidBy string `xml:"InternationalShippingCostPaidBy" json:"InternationalShippingCostPaidBy"`
+	Refund                          string `xml:"Refund" json:"Refund"`
+	ReturnsAccepted                 string `xml:"ReturnsAccepted" json:"ReturnsAccepted"`
+	ReturnsWithin                   string `xml:"ReturnsWithin" json:"ReturnsWithin"`
+	ShippingCostPaidBy              string `xml:"ShippingCostPaidBy" json:"ShippingCostPaidBy"`
 }
 
 // Seller ...
 type Seller struct {
 	BasicUser
-	TopRatedSeller bool `xml:"TopRatedSeller"`
+	TopRatedSeller bool `xml:"TopRatedSeller" json:"TopRatedSeller"`
 }
 
 // ItemShippingCostSummary returns a few details of the lowest-priced shipping service option that is available
 // to the eBay user making the call. For Calculated shipping, the item's location and the destination location
 // are considered when calculating the shipping cost.
 type ItemShippingCostSummary struct {
-	ListedShippingServiceCost float64 `xml:"ListedShippingServiceCost"`
-	LocalPickup               bool    `xml:"LocalPickup"`
-	ShippingServiceCost       float64 `xml:"ShippingServiceCost"`
-	ShippingType              string  `xml:"ShippingType"`
+	ListedShippingServiceCost Money  `xml:"ListedShippingServiceCost" json:"ListedShippingServiceCost"`
+	LocalPickup               bool   `xml:"LocalPickup" json:"LocalPickup"`
+	ShippingServiceCost       Money  `xml:"ShippingServiceCost" json:"ShippingServiceCost"`
+	ShippingType              string `xml:"ShippingType" json:"ShippingType"`
 }
 
 // Storefront consists of the eBay seller's store name and the URL to the eBay store. This container
 // is returned if the seller has an eBay Store subscription and the IncludeSelector field is included in
 // the call request and set to Details.
 type Storefront struct {
-	StoreName string `xml:"StoreName"`
-	StoreURL  string `xml:"StoreURL"`
+	StoreName string `xml:"StoreName" json:"StoreName"`
+	StoreURL  string `xml:"StoreURL" json:"StoreURL"`
 }
 
 // UnitInfo contains information about the weight, volume or other quantity measurement of a listed item so
@@ -309,42 +303,42 @@ type Storefront struct {
 // to include the price per unit. eBay uses this information and the item's listed price to calculate
 // and display the unit price on eBay EU sites.
 type UnitInfo struct {
-	UnitQuantity float64 `xml:"UnitQuantity"`
-	UnitType     string  `xml:"UnitType"`
+	UnitQuantity float64 `xml:"UnitQuantity" json:"UnitQuantity"`
+	UnitType     string  `xml:"UnitType" json:"UnitType"`
 }
 
 // Variations is only returned for multiple-variation listings, and it is required that the user include
 // the IncludeSelector field in the call request, and set its value to Variations.
 type Variations struct {
-	Pictures              Pictures        `xml:"Pictures"`
-	Variations            []Variation     `xml:"Variation"`
-	VariationSpecificsSet []NameValueList `xml:"VariationSpecificsSet>NameValueList"`
+	Pictures              Pictures        `xml:"Pictures" json:"Pictures"`
+	Variations            []Variation     `xml:"Variation" json:"Variation"`
+	VariationSpecificsSet []NameValueList `xml:"VariationSpecificsSet>NameValueList" json:"-"`
 }
 
 // Pictures contains a set of pictures that correspond to one of the variation specifics, such as 'Color'.
 type Pictures struct {
-	VariationSpecificName        string              `xml:"VariationSpecificName"`
-	VariationSpecificPictureSets []VarSpecificPicSet `xml:"VariationSpecificPictureSet"`
+	VariationSpecificName        string              `xml:"VariationSpecificName" json:"VariationSpecificName"`
+	VariationSpecificPictureSets []VarSpecificPicSet `xml:"VariationSpecificPictureSet" json:"VariationSpecificPictureSet"`
 }
 
 // VarSpecificPicSet is returned for each product variation for which there are one or more pictures available,
 // helping buyers distinguish between the different variations in the listing.
 type VarSpecificPicSet struct {
-	PictureURLs            []string `xml:"PictureURL"`
-	VariationSpecificValue string   `xml:"VariationSpecificValue"`
+	PictureURLs            []string `xml:"PictureURL" json:"PictureURL"`
+	VariationSpecificValue string   `xml:"VariationSpecificValue" json:"VariationSpecificValue"`
 }
 
 // Variation Contains data that distinguishes one variation from another. For example, if the items vary by
 // color and size, each Variation node specifies a combination of one of those colors and sizes.
 // The quantity and price for each variation is also shown in the Variation container
 type Variation struct {
-	DiscountPriceInfo  DiscountPriceInfo `xml:"DiscountPriceInfo"`
-	ProductID          string            `xml:"ProductID"`
-	Quantity           int               `xml:"Quantity"`
-	SellingStatus      SellingStatus     `xml:"SellingStatus"`
-	SKU                string            `xml:"SKU"`
-	StartPrice         float64           `xml:"StartPrice"`
-	VariationSpecifics []NameValueList   `xml:"VariationSpecifics>NameValueList"`
+	DiscountPriceInfo  DiscountPriceInfo `xml:"DiscountPriceInfo" json:"DiscountPriceInfo"`
+	ProductID          string            `xml:"ProductID" json:"ProductID"`
+	Quantity           int               `xml:"Quantity" json:"Quantity"`
+	SellingStatus      SellingStatus     `xml:"SellingStatus" json:"SellingStatus"`
+	SKU                string            `xml:"SKU" json:"SKU"`
+	StartPrice         Money             `xml:"StartPrice" json:"StartPrice"`
+	VariationSpecifics []NameValueList   `xml:"VariationSpecifics>NameValueList" json:"-"`
 }
 
 // SellingStatus shows the quantity sold for the variation, including the quantity that is sold through
@@ -352,8 +346,8 @@ type Variation struct {
 // with 'brick and mortar' stores). The SellingStatus container is returned for each item variation,
 // even if the quantity sold value is '0'.
 type SellingStatus struct {
-	QuantitySold                int `xml:"QuantitySold"`
-	QuantitySoldByPickupInStore int `xml:"QuantitySoldByPickupInStore"`
+	QuantitySold                int `xml:"QuantitySold" json:"QuantitySold"`
+	QuantitySoldByPickupInStore int `xml:"QuantitySoldByPickupInStore" json:"QuantitySoldByPickupInStore"`
 }
 
 /*
@@ -363,65 +357,65 @@ type SellingStatus struct {
 // GetShippingCostsResponse is a response for GetShippingCostsRequest
 type GetShippingCostsResponse struct {
 	responseStandard
-	PickUpInStoreDetails PickUpInStoreDetails `xml:"PickUpInStoreDetails"`
-	ShippingCostSummary  ShippingCostSummary  `xml:"ShippingCostSummary"`
-	ShippingDetails      ShippingDetails      `xml:"ShippingDetails"`
+	PickUpInStoreDetails PickUpInStoreDetails `xml:"PickUpInStoreDetails" json:"PickUpInStoreDetails"`
+	ShippingCostSummary  ShippingCostSummary  `xml:"ShippingCostSummary" json:"ShippingCostSummary"`
+	ShippingDetails      ShippingDetails      `xml:"ShippingDetails" json:"ShippingDetails"`
 }
 
 // PickUpInStoreDetails is only returned in GetShippingCosts if In-Store Pickup is available for the listing.
 type PickUpInStoreDetails struct {
-	AvailableForPickupInStore bool `xml:"AvailableForPickupInStore"`
-	EligibleForPickupInStore  bool `xml:"EligibleForPickupInStore"`
+	AvailableForPickupInStore bool `xml:"AvailableForPickupInStore" json:"AvailableForPickupInStore"`
+	EligibleForPickupInStore  bool `xml:"EligibleForPickupInStore" json:"EligibleForPickupInStore"`
 }
 
 // ShippingCostSummary returns a few details of the lowest-priced shipping service option that is
 // available to the shipping destination specified in the call request.
 type ShippingCostSummary struct {
-	ImportCharge              Price  `xml:"ImportCharge"`
-	InsuranceCost             Price  `xml:"InsuranceCost"`
-	InsuranceOption           string `xml:"InsuranceOption"`
-	ListedShippingServiceCost Price  `xml:"ListedShippingServiceCost"`
-	ShippingServiceCost       Price  `xml:"ShippingServiceCost"`
-	ShippingServiceName       string `xml:"ShippingServiceName"`
-	ShippingType              string `xml:"ShippingType"`
+	ImportCharge              Price  `xml:"ImportCharge" json:"ImportCharge"`
+	InsuranceCost             Price  `xml:"InsuranceCost" json:"InsuranceCost"`
+	InsuranceOption           string `xml:"InsuranceOption" json:"InsuranceOption"`
+	ListedShippingServiceCost Price  `xml:"ListedShippingServiceCost" json:"ListedShippingServiceCost"`
+	ShippingServiceCost       Price  `xml:"ShippingServiceCost" json:"ShippingServiceCost"`
+	ShippingServiceName       string `xml:"ShippingServiceName" json:"ShippingServiceName"`
+	ShippingType              string `xml:"ShippingType" json:"ShippingType"`
 }
 
 // ShippingDetails consists of shipping details related to the specified item and specified shipping destination.
 // This container is only returned if the IncludeDetails field is included and set to true in the call request.
 type ShippingDetails struct {
-	CODCost                             Price                   `xml:"CODCost"`
-	ExcludeShipToLocations              []string                `xml:"ExcludeShipToLocation"`
-	InsuranceCost                       Price                   `xml:"InsuranceCost"`
-	InsuranceOption                     string                  `xml:"InsuranceOption"`
-	InternationalInsuranceCost          Price                   `xml:"InternationalInsuranceCost"`
-	InternationalInsuranceOption        string                  `xml:"InternationalInsuranceOption"`
-	InternationalShippingServiceOptions []IntShipServiceOption  `xml:"InternationalShippingServiceOption"`
-	SalesTax                            SalesTax                `xml:"SalesTax"`
-	ShippingRateErrorMessage            string                  `xml:"ShippingRateErrorMessage"`
-	ShippingServiceOptions              []ShippingServiceOption `xml:"ShippingServiceOption"`
-	TaxTable                            []TaxJurisdiction       `xml:"TaxTable>TaxJurisdiction"`
+	CODCost                             Price                   `xml:"CODCost" json:"CODCost"`
+	ExcludeShipToLocations              []string                `xml:"ExcludeShipToLocation" json:"ExcludeShipToLocation"`
+	InsuranceCost                       Price                   `xml:"InsuranceCost" json:"InsuranceCost"`
+	InsuranceOption                     string                  `xml:"InsuranceOption" json:"InsuranceOption"`
+	InternationalInsuranceCost          Price                   `xml:"InternationalInsuranceCost" json:"InternationalInsuranceCost"`
+	InternationalInsuranceOption        string                  `xml:"InternationalInsuranceOption" json:"InternationalInsuranceOption"`
+	InternationalShippingServiceOptions []IntShipServiceOption  `xml:"InternationalShippingServiceOption" json:"InternationalShippingServiceOption"`
+	SalesTax                            SalesTax                `xml:"SalesTax" json:"SalesTax"`
+	ShippingRateErrorMessage            string                  `xml:"ShippingRateErrorMessage" json:"ShippingRateErrorMessage"`
+	ShippingServiceOptions              []ShippingServiceOption `xml:"ShippingServiceOption" json:"ShippingServiceOption"`
+	TaxTable                            []TaxJurisdiction       `xml:"TaxTable>TaxJurisdiction" json:"-"`
 }
 
 // IntShipServiceOption consists of detailed information for an international shipping service option that is
 // available to an international buyer located at the shipping destination specified in the call request.
 type IntShipServiceOption struct {
-	EstimatedDeliveryMaxTime      string   `xml:"EstimatedDeliveryMaxTime"`
-	EstimatedDeliveryMinTime      string   `xml:"EstimatedDeliveryMinTime"`
-	ImportCharge                  Price    `xml:"ImportCharge"`
-	ShippingServiceAdditionalCost Price    `xml:"ShippingServiceAdditionalCost"`
-	ShippingServiceCost           Price    `xml:"ShippingServiceCost"`
-	ShippingServiceCutOffTime     string   `xml:"ShippingServiceCutOffTime"`
-	ShippingServiceName           string   `xml:"ShippingServiceName"`
-	ShippingServicePriority       int      `xml:"ShippingServicePriority"`
-	ShipsTo                       []string `xml:"ShipsTo"`
+	EstimatedDeliveryMaxTime      EbayTime `xml:"EstimatedDeliveryMaxTime" json:"EstimatedDeliveryMaxTime"`
+	EstimatedDeliveryMinTime      EbayTime `xml:"EstimatedDeliveryMinTime" json:"EstimatedDeliveryMinTime"`
+	ImportCharge                  Price    `xml:"ImportCharge" json:"ImportCharge"`
+	ShippingServiceAdditionalCost Price    `xml:"ShippingServiceAdditionalCost" json:"ShippingServiceAdditionalCost"`
+	ShippingServiceCost           Price    `xml:"ShippingServiceCost" json:"ShippingServiceCost"`
+	ShippingServiceCutOffTime     EbayTime `xml:"ShippingServiceCutOffTime" json:"ShippingServiceCutOffTime"`
+	ShippingServiceName           string   `xml:"ShippingServiceName" json:"ShippingServiceName"`
+	ShippingServicePriority       int      `xml:"ShippingServicePriority" json:"ShippingServicePriority"`
+	ShipsTo                       []string `xml:"ShipsTo" json:"ShipsTo"`
 }
 
 // SalesTax is used to express sales tax details for the shipping destination.
 type SalesTax struct {
-	SalesTaxAmount        Price   `xml:"SalesTaxAmount"`
-	SalesTaxPercent       float64 `xml:"SalesTaxPercent"`
-	SalesTaxState         string  `xml:"SalesTaxState"`
-	ShippingIncludedInTax bool    `xml:"ShippingIncludedInTax"`
+	SalesTaxAmount        Price   `xml:"SalesTaxAmount" json:"SalesTaxAmount"`
+	SalesTaxPercent       float64 `xml:"SalesTaxPercent" json:"SalesTaxPercent"`
+	SalesTaxState         string  `xml:"SalesTaxState" json:"SalesTaxState"`
+	ShippingIncludedInTax bool    `xml:"ShippingIncludedInTax" json:"ShippingIncludedInTax"`
 }
 
 // ShippingServiceOption  consists of detailed information for a domestic shipping
@@ -429,21 +423,21 @@ type SalesTax struct {
 // the call request. A ShippingServiceOption container is returned for each available domestic shipping
 // service option. A seller can specify up to four domestic shipping service options in an eBay listing.
 type ShippingServiceOption struct {
-	EstimatedDeliveryMaxTime      string   `xml:"EstimatedDeliveryMaxTime"`
-	EstimatedDeliveryMinTime      string   `xml:"EstimatedDeliveryMinTime"`
-	ExpeditedService              bool     `xml:"ExpeditedService"`
-	FastAndFree                   bool     `xml:"FastAndFree"`
-	LogisticPlanType              string   `xml:"LogisticPlanType"`
-	ShippingInsuranceCost         Price    `xml:"ShippingInsuranceCost"`
-	ShippingServiceAdditionalCost Price    `xml:"ShippingServiceAdditionalCost"`
-	ShippingServiceCost           Price    `xml:"ShippingServiceCost"`
-	ShippingServiceCutOffTime     string   `xml:"ShippingServiceCutOffTime"`
-	ShippingServiceName           string   `xml:"ShippingServiceName"`
-	ShippingServicePriority       int      `xml:"ShippingServicePriority"`
-	ShippingSurcharge             Price    `xml:"ShippingSurcharge"`
-	ShippingTimeMax               int      `xml:"ShippingTimeMax"`
-	ShippingTimeMin               int      `xml:"ShippingTimeMin"`
-	ShipsTo                       []string `xml:"ShipsTo"`
+	EstimatedDeliveryMaxTime      EbayTime `xml:"EstimatedDeliveryMaxTime" json:"EstimatedDeliveryMaxTime"`
+	EstimatedDeliveryMinTime      EbayTime `xml:"EstimatedDeliveryMinTime" json:"EstimatedDeliveryMinTime"`
+	ExpeditedService              bool     `xml:"ExpeditedService" json:"ExpeditedService"`
+	FastAndFree                   bool     `xml:"FastAndFree" json:"FastAndFree"`
+	LogisticPlanType              string   `xml:"LogisticPlanType" json:"LogisticPlanType"`
+	ShippingInsuranceCost         Price    `xml:"ShippingInsuranceCost" json:"ShippingInsuranceCost"`
+	ShippingServiceAdditionalCost Price    `xml:"ShippingServiceAdditionalCost" json:"ShippingServiceAdditionalCost"`
+	ShippingServiceCost           Price    `xml:"ShippingServiceCost" json:"ShippingServiceCost"`
+	ShippingServiceCutOffTime     EbayTime `xml:"ShippingServiceCutOffTime" json:"ShippingServiceCutOffTime"`
+	ShippingServiceName           string   `xml:"ShippingServiceName" json:"ShippingServiceName"`
+	ShippingServicePriority       int      `xml:"ShippingServicePriority" json:"ShippingServicePriority"`
+	ShippingSurcharge             Price    `xml:"ShippingSurcharge" json:"ShippingSurcharge"`
+	ShippingTimeMax               int      `xml:"ShippingTimeMax" json:"ShippingTimeMax"`
+	ShippingTimeMin               int      `xml:"ShippingTimeMin" json:"ShippingTimeMin"`
+	ShipsTo                       []string `xml:"ShipsTo" json:"ShipsTo"`
 }
 
 // TaxTable consists of an array of TaxJurisdiction containers; one returned for each tax jurisdiction where
@@ -451,14 +445,14 @@ type ShippingServiceOption struct {
 // The TaxTable container is returned as an empty element if no sales tax rates have been set up for
 // any jurisdictions.
 type TaxTable struct {
-	TaxJurisdictions []TaxJurisdiction `xml:"TaxJurisdiction"`
+	TaxJurisdictions []TaxJurisdiction `xml:"TaxJurisdiction" json:"TaxJurisdiction"`
 }
 
 // TaxJurisdiction ...
 type TaxJurisdiction struct {
-	JurisdictionID        string  `xml:"JurisdictionID"`
-	SalesTaxPercent       float64 `xml:"SalesTaxPercent"`
-	ShippingIncludedInTax bool    `xml:"ShippingIncludedInTax"`
+	JurisdictionID        string  `xml:"JurisdictionID" json:"JurisdictionID"`
+	SalesTaxPercent       float64 `xml:"SalesTaxPercent" json:"SalesTaxPercent"`
+	ShippingIncludedInTax bool    `xml:"ShippingIncludedInTax" json:"ShippingIncludedInTax"`
 }
 
 /*
@@ -468,19 +462,19 @@ type TaxJurisdiction struct {
 // GetSingleItemResponse is a response for GetSingleItemRequest
 type GetSingleItemResponse struct {
 	responseStandard
-	Item ItemExtended `xml:"Item"`
+	Item ItemExtended `xml:"Item" json:"Item"`
 }
 
 // ItemExtended contains details about the listing whose ID was specified in the request.
 type ItemExtended struct {
 	Item
-	ItemCompatibilityCount int             `xml:"ItemCompatibilityCount"`
-	ItemCompatibilityList  []Compatibility `xml:"ItemCompatibilityList>Compatibility"`
+	ItemCompatibilityCount int             `xml:"ItemCompatibilityCount" json:"ItemCompatibilityCount"`
+	ItemCompatibilityList  []Compatibility `xml:"ItemCompatibilityList>Compatibility" json:"-"`
 }
 
 // Compatibility is returned for each motor vehicle that is compatible with the motor vehicle part or accessory.
 type Compatibility struct {
-	CompatibilityNotes string `xml:"CompatibilityNotes"`
+	CompatibilityNotes string `xml:"CompatibilityNotes" json:"CompatibilityNotes"`
 	NameValueLists     []NameValueList
 }
 
@@ -491,31 +485,31 @@ type Compatibility struct {
 // GetUserProfileResponse is a response of GetUserProfileRequest
 type GetUserProfileResponse struct {
 	responseStandard
-	FeedbackDetails []FeedbackDetail `xml:"FeedbackDetails"`
-	FeedbackHistory FeedbackHistory  `xml:"FeedbackHistory"`
-	User            UserProfile      `xml:"User"`
+	FeedbackDetails []FeedbackDetail `xml:"FeedbackDetails" json:"FeedbackDetails"`
+	FeedbackHistory FeedbackHistory  `xml:"FeedbackHistory" json:"FeedbackHistory"`
+	User            UserProfile      `xml:"User" json:"User"`
 }
 
 // FeedbackDetail consists of detailed information about one Feedback entry for the specified eBay user.
 type FeedbackDetail struct {
-	CommentingUser      string  `xml:"CommentingUser"`
-	CommentingUserScore int     `xml:"CommentingUserScore"`
-	CommentText         string  `xml:"CommentText"`
-	CommentTime         string  `xml:"CommentTime"`
-	CommentType         string  `xml:"CommentType"`
-	FeedbackID          string  `xml:"FeedbackID"`
-	FeedbackRatingStar  string  `xml:"FeedbackRatingStar"`
-	FeedbackResponse    string  `xml:"FeedbackResponse"`
-	FollowUp            string  `xml:"FollowUp"`
-	ItemID              string  `xml:"ItemID"`
-	ItemPrice           float64 `xml:"ItemPrice"`
-	ItemTitle           string  `xml:"ItemTitle"`
-	Role                string  `xml:"Role"`
-	TransactionID       string  `xml:"TransactionID"`
-	CommentReplaced     bool    `xml:"CommentReplaced"`
-	Countable           bool    `xml:"Countable"`
-	FollowUpReplaced    bool    `xml:"FollowUpReplaced"`
-	ResponseReplaced    bool    `xml:"ResponseReplaced"`
+	CommentingUser      string   `xml:"CommentingUser" json:"CommentingUser"`
+	CommentingUserScore int      `xml:"CommentingUserScore" json:"CommentingUserScore"`
+	CommentText         string   `xml:"CommentText" json:"CommentText"`
+	CommentTime         EbayTime `xml:"CommentTime" json:"CommentTime"`
+	CommentType         string   `xml:"CommentType" json:"CommentType"`
+	FeedbackID          string   `xml:"FeedbackID" json:"FeedbackID"`
+	FeedbackRatingStar  string   `xml:"FeedbackRatingStar" json:"FeedbackRatingStar"`
+	FeedbackResponse    string   `xml:"FeedbackResponse" json:"FeedbackResponse"`
+	FollowUp            string   `xml:"FollowUp" json:"FollowUp"`
+	ItemID              string   `xml:"ItemID" json:"ItemID"`
+	ItemPrice           Money    `xml:"ItemPrice" json:"ItemPrice"`
+	ItemTitle           string   `xml:"ItemTitle" json:"ItemTitle"`
+	Role                string   `xml:"Role" json:"Role"`
+	TransactionID       string   `xml:"TransactionID" json:"TransactionID"`
+	CommentReplaced     bool     `xml:"CommentReplaced" json:"CommentReplaced"`
+	Countable           bool     `xml:"Countable" json:"Countable"`
+	FollowUpReplaced    bool     `xml:"FollowUpReplaced" json:"FollowUpReplaced"`
+	ResponseReplaced    bool     `xml:"ResponseReplaced" json:"ResponseReplaced"`
 }
 
 // FeedbackHistory consists of numerous statistical data about the specified eBay user's Feedback history,
@@ -523,32 +517,32 @@ type FeedbackDetail struct {
 // (last week, last month, last 6 months, and last year). For the FeedbackHistory container to be returned,
 // the user must include the IncludeSelector field in the request and set its value to FeedbackHistory.
 type FeedbackHistory struct {
-	AverageRatingDetails                  []AverageRatingDetail `xml:"AverageRatingDetails"`
-	BidRetractionFeedbackPeriods          []FeedbackPeriod      `xml:"BidRetractionFeedbackPeriods"`
-	NegativeFeedbackPeriods               []FeedbackPeriod      `xml:"NegativeFeedbackPeriods"`
-	NeutralCommentCountFromSuspendedUsers int64                 `xml:"NeutralCommentCountFromSuspendedUsers"`
-	NeutralFeedbackPeriods                []FeedbackPeriod      `xml:"NeutralFeedbackPeriods"`
-	PositiveFeedbackPeriods               []FeedbackPeriod      `xml:"PositiveFeedbackPeriods"`
-	TotalFeedbackPeriods                  []FeedbackPeriod      `xml:"TotalFeedbackPeriods"`
-	UniqueNegativeFeedbackCount           int64                 `xml:"UniqueNegativeFeedbackCount"`
-	UniqueNeutralFeedbackCount            int64                 `xml:"UniqueNeutralFeedbackCount"`
-	UniquePositiveFeedbackCount           int64                 `xml:"UniquePositiveFeedbackCount"`
+	AverageRatingDetails                  []AverageRatingDetail `xml:"AverageRatingDetails" json:"AverageRatingDetails"`
+	BidRetractionFeedbackPeriods          []FeedbackPeriod      `xml:"BidRetractionFeedbackPeriods" json:"BidRetractionFeedbackPeriods"`
+	NegativeFeedbackPeriods               []FeedbackPeriod      `xml:"NegativeFeedbackPeriods" json:"NegativeFeedbackPeriods"`
+	NeutralCommentCountFromSuspendedUsers int64                 `xml:"NeutralCommentCountFromSuspendedUsers" json:"NeutralCommentCountFromSuspendedUsers"`
+	NeutralFeedbackPeriods                []FeedbackPeriod      `xml:"NeutralFeedbackPeriods" json:"NeutralFeedbackPeriods"`
+	PositiveFeedbackPeriods               []FeedbackPeriod      `xml:"PositiveFeedbackPeriods" json:"PositiveFeedbackPeriods"`
+	TotalFeedbackPeriods                  []FeedbackPeriod      `xml:"TotalFeedbackPeriods" json:"TotalFeedbackPeriods"`
+	UniqueNegativeFeedbackCount           int64                 `xml:"UniqueNegativeFeedbackCount" json:"UniqueNegativeFeedbackCount"`
+	UniqueNeutralFeedbackCount            int64                 `xml:"UniqueNeutralFeedbackCount" json:"UniqueNeutralFeedbackCount"`
+	UniquePositiveFeedbackCount           int64                 `xml:"UniquePositiveFeedbackCount" json:"UniquePositiveFeedbackCount"`
 }
 
 // AverageRatingDetail shows the seller's current rating for the Detailed Seller Rating type (specified in the
 // RatingDetail field), as well as the total count that this seller has been rated for
 // this particular Detailed Seller Rating type.
 type AverageRatingDetail struct {
-	Rating       float64 `xml:"Rating"`
-	RatingCount  int64   `xml:"RatingCount"`
-	RatingDetail string  `xml:"RatingDetail"`
+	Rating       float64 `xml:"Rating" json:"Rating"`
+	RatingCount  int64   `xml:"RatingCount" json:"RatingCount"`
+	RatingDetail string  `xml:"RatingDetail" json:"RatingDetail"`
 }
 
 // FeedbackPeriod shows the cumulative number of all Feedback entries (shown in Count field) for the specified
 // time period (shown in PeriodInDays field).
 type FeedbackPeriod struct {
-	Count        int64 `xml:"Count"`
-	PeriodInDays int   `xml:"PeriodInDays"`
+	Count        int64 `xml:"Count" json:"Count"`
+	PeriodInDays int   `xml:"PeriodInDays" json:"PeriodInDays"`
 }
 
 // UserProfile consists of various details about the eBay user, including Feedback rating, Seller Level,
@@ -556,20 +550,20 @@ type FeedbackPeriod struct {
 // under this container if the user includes the IncludeSelector field in the request and sets its value to Details.
 type UserProfile struct {
 	BasicUser
-	AboutMeURL          string `xml:"AboutMeURL"`
-	FeedbackDetailsURL  bool   `xml:"FeedbackDetailsURL"`
-	MyWorldLargeImage   string `xml:"MyWorldLargeImage"`
-	MyWorldSmallImage   string `xml:"MyWorldSmallImage"`
-	MyWorldURL          string `xml:"MyWorldURL"`
-	NewUser             bool   `xml:"NewUser"`
-	RegistrationDate    string `xml:"RegistrationDate"`
-	RegistrationSite    string `xml:"RegistrationSite"`
-	ReviewsAndGuidesURL string `xml:"ReviewsAndGuidesURL"`
-	SellerBusinessType  string `xml:"SellerBusinessType"`
-	SellerItemsURL      string `xml:"SellerItemsURL"`
-	SellerLevel         string `xml:"SellerLevel"`
-	Status              string `xml:"Status"`
-	StoreName           string `xml:"StoreName"`
-	StoreURL            string `xml:"StoreURL"`
-	TopRatedSeller      bool   `xml:"TopRatedSeller"`
+	AboutMeURL          string   `xml:"AboutMeURL" json:"AboutMeURL"`
+	FeedbackDetailsURL  bool     `xml:"FeedbackDetailsURL" json:"FeedbackDetailsURL"`
+	MyWorldLargeImage   string   `xml:"MyWorldLargeImage" json:"MyWorldLargeImage"`
+	MyWorldSmallImage   string   `xml:"MyWorldSmallImage" json:"MyWorldSmallImage"`
+	MyWorldURL          string   `xml:"MyWorldURL" json:"MyWorldURL"`
+	NewUser             bool     `xml:"NewUser" json:"NewUser"`
+	RegistrationDate    EbayTime `xml:"RegistrationDate" json:"RegistrationDate"`
+	RegistrationSite    string   `xml:"RegistrationSite" json:"RegistrationSite"`
+	ReviewsAndGuidesURL string   `xml:"ReviewsAndGuidesURL" json:"ReviewsAndGuidesURL"`
+	SellerBusinessType  string   `xml:"SellerBusinessType" json:"SellerBusinessType"`
+	SellerItemsURL      string   `xml:"SellerItemsURL" json:"SellerItemsURL"`
+	SellerLevel         string   `xml:"SellerLevel" json:"SellerLevel"`
+	Status              string   `xml:"Status" json:"Status"`
+	StoreName           string   `xml:"StoreName" json:"StoreName"`
+	StoreURL            string   `xml:"StoreURL" json:"StoreURL"`
+	TopRatedSeller      bool     `xml:"TopRatedSeller" json:"TopRatedSeller"`
 }
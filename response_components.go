@@ -1,16 +1,136 @@
 package shopping
 
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
 type responseStandard struct {
-	Ack   string `xml:"Ack"`
-	Build string `xml:"Build"`
+	Ack   string `xml:"Ack" json:"Ack"`
+	Build string `xml:"Build" json:"Build"`
 	// CorrelationID. If you pass a value in MessageID in a request, we will return the same value
 	// in CorrelationID in the response. You can use this for tracking that a response is returned
 	// for every request and to match particular responses to particular requests.
 	// Only returned if MessageID was used.
-	CorrelationID string  `xml:"CorrelationID"`
-	Errors        []Error `xml:"Errors"`
-	Timestamp     string  `xml:"Timestamp"`
-	Version       string  `xml:"Version"`
+	CorrelationID string   `xml:"CorrelationID" json:"CorrelationID"`
+	Errors        []Error  `xml:"Errors" json:"Errors"`
+	Timestamp     EbayTime `xml:"Timestamp" json:"Timestamp"`
+	Version       string   `xml:"Version" json:"Version"`
+	// Attempts is the number of calls made to eBay to get the response (see RetryPolicy).
+	// It is 0 if the response was taken from the service cache.
+	Attempts int `xml:"-" json:"-"`
+}
+
+// standard gives access to the fields which are common for all responses
+func (r *responseStandard) standard() *responseStandard {
+	return r
+}
+
+// apiError returns *APIError if Ack is Failure or PartialFailure
+func (r *responseStandard) apiError(operation EbayOperation, statusCode int) error {
+	if r.Ack != AckFailure && r.Ack != AckPartialFailure {
+		return nil
+	}
+	return &APIError{
+		Operation:     operation,
+		StatusCode:    statusCode,
+		Ack:           r.Ack,
+		CorrelationID: r.CorrelationID,
+		Errors:        r.Errors,
+	}
+}
+
+// envelopeFields are the elements of responseStandard. eBay returns them before the elements of the call.
+var envelopeFields = map[string]bool{
+	"Timestamp":     true,
+	"Ack":           true,
+	"CorrelationID": true,
+	"Errors":        true,
+	"Build":         true,
+	"Version":       true,
+}
+
+// unmarshalXMLEnvelope decodes only the fields of responseStandard from XML response.
+// It stops at the first element of the call, so large responses are not decoded twice.
+func unmarshalXMLEnvelope(data []byte, rs *responseStandard) error {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	root := false
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if !root {
+			root = true
+			continue
+		}
+		if !envelopeFields[start.Name.Local] {
+			return nil
+		}
+		switch start.Name.Local {
+		case "Ack":
+			err = d.DecodeElement(&rs.Ack, &start)
+		case "CorrelationID":
+			err = d.DecodeElement(&rs.CorrelationID, &start)
+		case "Errors":
+			var e Error
+			err = d.DecodeElement(&e, &start)
+			rs.Errors = append(rs.Errors, e)
+		default:
+			err = d.Skip()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// unmarshalJSONEnvelope decodes only the fields of responseStandard from JSON response.
+// It stops at the first field of the call, so large responses are not decoded twice.
+func unmarshalJSONEnvelope(data []byte, rs *responseStandard) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := d.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('{') {
+		return fmt.Errorf("expected object, got %v", tok)
+	}
+	for d.More() {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+		if !envelopeFields[key] {
+			return nil
+		}
+		switch key {
+		case "Ack":
+			err = d.Decode(&rs.Ack)
+		case "CorrelationID":
+			err = d.Decode(&rs.CorrelationID)
+		case "Errors":
+			err = d.Decode(&rs.Errors)
+		default:
+			var skip json.RawMessage
+			err = d.Decode(&skip)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Error is request errors (as opposed to system errors) that occur due to problems with
@@ -18,22 +138,204 @@ type responseStandard struct {
 type Error struct {
 	// ErrorClassification. Look here
 	// https://developer.ebay.com/Devzone/shopping/docs/CallRef/types/ErrorClassificationCodeType.html
-	ErrorClassification string           `xml:"ErrorClassification"`
-	ErrorCode           string           `xml:"ErrorCode"`
-	ErrorParameters     []ErrorParameter `xml:"ErrorParameters"`
-	LongMessage         string           `xml:"LongMessage"`
+	ErrorClassification string           `xml:"ErrorClassification" json:"ErrorClassification"`
+	ErrorCode           string           `xml:"ErrorCode" json:"ErrorCode"`
+	ErrorParameters     []ErrorParameter `xml:"ErrorParameters" json:"ErrorParameters"`
+	LongMessage         string           `xml:"LongMessage" json:"LongMessage"`
 	// SeverityCode. Look here https://developer.ebay.com/Devzone/shopping/docs/CallRef/types/SeverityCodeType.html
-	SeverityCode string `xml:"SeverityCode"`
-	ShortMessage string `xml:"ShortMessage"`
+	SeverityCode string `xml:"SeverityCode" json:"SeverityCode"`
+	ShortMessage string `xml:"ShortMessage" json:"ShortMessage"`
 }
 
 // ErrorParameter is used by the ErrorParameters container if one or more errors or warnings occur with the call,
 // and if a specific request parameter has been pinpointed as the reason why the error or warning was triggered.
 type ErrorParameter struct {
-	ParamID string `xml:"ParamID,attr"`
-	Value   string `xml:"Value"`
+	ParamID string `xml:"ParamID,attr" json:"ParamID"`
+	Value   string `xml:"Value" json:"Value"`
 }
 
 /*
 =========================================================================
 */
+
+// EbayTime is eBay datetime value (e.g. 2021-11-27T00:28:30.123Z) which is parsed during XML decoding.
+// Raw keeps the original text of the value. Empty value is decoded as zero time.
+type EbayTime struct {
+	time.Time
+	Raw string
+}
+
+// UnmarshalXML implements xml.Unmarshaler
+func (t *EbayTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var raw string
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+	parsed, err := ParseEbayTime(raw)
+	if err != nil {
+		return fmt.Errorf("%s: %w", start.Name.Local, err)
+	}
+	*t = parsed
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *EbayTime) UnmarshalJSON(data []byte) error {
+	raw, ok, err := jsonText(data)
+	if err != nil || !ok {
+		return err
+	}
+	parsed, err := ParseEbayTime(raw)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The value is encoded as String.
+func (t EbayTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// MarshalXML implements xml.Marshaler
+func (t EbayTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(t.String(), start)
+}
+
+// String returns the original text of the value or formatted time if the original text is empty
+func (t EbayTime) String() string {
+	if t.Raw != "" || t.Time.IsZero() {
+		return t.Raw
+	}
+	return ToEbayDateTime(t.Time.UTC())
+}
+
+// ParseEbayTime parses eBay datetime value. Values without milliseconds are accepted as well.
+func ParseEbayTime(raw string) (EbayTime, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return EbayTime{Raw: raw}, nil
+	}
+	dt, err := FromEbayDateTime(value)
+	if err != nil {
+		dt, err = time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return EbayTime{}, fmt.Errorf("invalid eBay datetime %q", raw)
+		}
+	}
+	return EbayTime{Time: dt, Raw: raw}, nil
+}
+
+// EbayDuration is eBay duration value (e.g. P2DT23H32M51S) which is parsed during XML decoding.
+// Raw keeps the original text of the value. Empty value is decoded as zero duration.
+type EbayDuration struct {
+	time.Duration
+	Raw string
+}
+
+// UnmarshalXML implements xml.Unmarshaler
+func (d *EbayDuration) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var raw string
+	if err := dec.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+	parsed, err := ParseEbayDuration(raw)
+	if err != nil {
+		return fmt.Errorf("%s: %w", start.Name.Local, err)
+	}
+	*d = parsed
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *EbayDuration) UnmarshalJSON(data []byte) error {
+	raw, ok, err := jsonText(data)
+	if err != nil || !ok {
+		return err
+	}
+	parsed, err := ParseEbayDuration(raw)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The value is encoded as String.
+func (d EbayDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// MarshalXML implements xml.Marshaler
+func (d EbayDuration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(d.String(), start)
+}
+
+// String returns the original text of the value or formatted duration if the original text is empty
+func (d EbayDuration) String() string {
+	if d.Raw != "" || d.Duration == 0 {
+		return d.Raw
+	}
+	return ToEbayDuration(d.Duration)
+}
+
+// ParseEbayDuration parses eBay duration value in ISO 8601 format PnYnMnDTnHnMnS.
+// Years are counted as 365 days and months as 30 days.
+func ParseEbayDuration(raw string) (EbayDuration, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return EbayDuration{Raw: raw}, nil
+	}
+	invalid := fmt.Errorf("invalid eBay duration %q", raw)
+	if len(value) < 2 || value[0] != 'P' {
+		return EbayDuration{}, invalid
+	}
+	var d time.Duration
+	var n int64
+	digits, timePart, timeUnits := false, false, false
+	for _, b := range value[1:] {
+		if b >= '0' && b <= '9' {
+			n = n*10 + int64(b-'0')
+			digits = true
+			continue
+		}
+		if b == 'T' {
+			if timePart || digits {
+				return EbayDuration{}, invalid
+			}
+			timePart = true
+			continue
+		}
+		if !digits {
+			return EbayDuration{}, invalid
+		}
+		var unit time.Duration
+		switch {
+		case b == 'Y' && !timePart:
+			unit = 365 * 24 * time.Hour
+		case b == 'M' && !timePart:
+			unit = 30 * 24 * time.Hour
+		case b == 'W' && !timePart:
+			unit = 7 * 24 * time.Hour
+		case b == 'D' && !timePart:
+			unit = 24 * time.Hour
+		case b == 'H' && timePart:
+			unit = time.Hour
+		case b == 'M' && timePart:
+			unit = time.Minute
+		case b == 'S' && timePart:
+			unit = time.Second
+		default:
+			return EbayDuration{}, invalid
+		}
+		d += time.Duration(n) * unit
+		n, digits = 0, false
+		timeUnits = timePart
+	}
+	// T must be followed by hours, minutes or seconds
+	if digits || timePart && !timeUnits {
+		return EbayDuration{}, invalid
+	}
+	return EbayDuration{Duration: d, Raw: raw}, nil
+}
@@ -0,0 +1,188 @@
+package shopping
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEbayTime_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			input: "2021-11-27T00:28:30.123Z",
+			want:  time.Date(2021, 11, 27, 0, 28, 30, 123000000, UTC),
+		},
+		{
+			input: "2021-11-27T00:28:30Z",
+			want:  time.Date(2021, 11, 27, 0, 28, 30, 0, UTC),
+		},
+		{
+			input: "",
+		},
+		{
+			input:   "27.11.2021",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var item StatusItem
+			err := xml.Unmarshal([]byte("<StatusItem><EndTime>"+tt.input+"</EndTime></StatusItem>"), &item)
+			if tt.wantErr {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), "EndTime")
+					assert.Contains(t, err.Error(), tt.input)
+				}
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.True(t, tt.want.Equal(item.EndTime.Time), "got %v", item.EndTime.Time)
+			assert.Equal(t, tt.input, item.EndTime.Raw)
+		})
+	}
+}
+
+func TestEbayDuration_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			input: "P2DT23H32M51S",
+			want:  time.Hour*24*2 + time.Hour*23 + time.Minute*32 + time.Second*51,
+		},
+		{
+			input: "PT5M",
+			want:  5 * time.Minute,
+		},
+		{
+			input: "P1M2D",
+			want:  32 * 24 * time.Hour,
+		},
+		{
+			input: "",
+		},
+		{
+			input:   "2DT23H",
+			wantErr: true,
+		},
+		{
+			input:   "P2DT23",
+			wantErr: true,
+		},
+		{
+			input:   "PT2D",
+			wantErr: true,
+		},
+		{
+			input:   "PT",
+			wantErr: true,
+		},
+		{
+			input:   "P1DT",
+			wantErr: true,
+		},
+		{
+			input:   "PT1HT",
+			wantErr: true,
+		},
+		{
+			input:   "PT1HT30M",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var item StatusItem
+			err := xml.Unmarshal([]byte("<StatusItem><TimeLeft>"+tt.input+"</TimeLeft></StatusItem>"), &item)
+			if tt.wantErr {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), "TimeLeft")
+				}
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, item.TimeLeft.Duration)
+			assert.Equal(t, tt.input, item.TimeLeft.Raw)
+		})
+	}
+}
+
+func TestEbayTime_MarshalXML(t *testing.T) {
+	item := StatusItem{
+		EndTime:  EbayTime{Time: time.Date(2021, 11, 27, 0, 28, 30, 123000000, UTC)},
+		TimeLeft: EbayDuration{Duration: 26*time.Hour + time.Second},
+	}
+	b, err := xml.Marshal(item)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var got StatusItem
+	if !assert.NoError(t, xml.Unmarshal(b, &got)) {
+		return
+	}
+	assert.Equal(t, "2021-11-27T00:28:30.123Z", got.EndTime.Raw)
+	assert.Equal(t, "P1DT2H0M1S", got.TimeLeft.Raw)
+	assert.Equal(t, item.TimeLeft.Duration, got.TimeLeft.Duration)
+}
+
+func TestUnmarshalEnvelope(t *testing.T) {
+	for _, op := range codecOperations {
+		t.Run(op.dir, func(t *testing.T) {
+			b, err := ioutil.ReadFile(path.Join("testdata", "response", "xml", op.dir, "Basic.xml"))
+			if !assert.NoError(t, err) {
+				return
+			}
+			full := op.response()
+			if !assert.NoError(t, xml.Unmarshal(b, full)) {
+				return
+			}
+			want := responseStandard{
+				Ack:           full.standard().Ack,
+				CorrelationID: full.standard().CorrelationID,
+				Errors:        full.standard().Errors,
+			}
+			got := responseStandard{}
+			if assert.NoError(t, unmarshalXMLEnvelope(b, &got)) {
+				assert.Equal(t, want, got)
+			}
+
+			b, err = ioutil.ReadFile(path.Join("testdata", "response", "json", op.dir, "Basic.json"))
+			if !assert.NoError(t, err) {
+				return
+			}
+			got = responseStandard{}
+			if assert.NoError(t, unmarshalJSONEnvelope(b, &got)) {
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalEnvelope_StopsAtCallFields(t *testing.T) {
+	rs := responseStandard{}
+	err := unmarshalXMLEnvelope([]byte(`<GetItemStatusResponse><Ack>Failure</Ack>`+
+		`<Errors><ErrorCode>10.12</ErrorCode></Errors><StatusItem><broken`), &rs)
+	if assert.NoError(t, err) {
+		assert.Equal(t, AckFailure, rs.Ack)
+		assert.Equal(t, []Error{{ErrorCode: "10.12"}}, rs.Errors)
+	}
+	rs = responseStandard{}
+	err = unmarshalJSONEnvelope([]byte(`{"Ack":"Warning","CorrelationID":"c1","Item":[{"broken`), &rs)
+	if assert.NoError(t, err) {
+		assert.Equal(t, responseStandard{Ack: AckWarning, CorrelationID: "c1"}, rs)
+	}
+}
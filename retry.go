@@ -0,0 +1,206 @@
+package shopping
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy describes how requests are retried after transient failures.
+// The policy is applied by Service to all operations.
+type RetryPolicy struct {
+	// MaxAttempts is a total number of attempts including the first one.
+	// Values lower than 2 disable retries.
+	MaxAttempts int
+	// BaseBackoff is a wait time before the first retry. It is doubled for each next retry.
+	BaseBackoff time.Duration
+	// MaxBackoff limits the wait time between attempts including the time from Retry-After header.
+	// Zero means DefaultMaxBackoff.
+	MaxBackoff time.Duration
+	// Jitter is a fraction (0..1) of the wait time which is randomized.
+	Jitter float64
+	// RetryableStatuses are HTTP status codes which are retried
+	RetryableStatuses []int
+	// RetryableErrorCodes are eBay error codes which are retried
+	RetryableErrorCodes []string
+	// RetrySystemErrors enables retries of eBay errors with ErrorClassification SystemError
+	RetrySystemErrors bool
+	// RetryTransportErrors enables retries of network errors (e.g. connection reset)
+	RetryTransportErrors bool
+	// RespectRetryAfter makes the policy wait for the time from Retry-After response header (if present)
+	RespectRetryAfter bool
+	// OnRetry is called before each retry. It can be used for logging.
+	OnRetry func(event RetryEvent)
+}
+
+// RetryEvent describes a failed attempt which is going to be retried
+type RetryEvent struct {
+	Operation EbayOperation
+	// Attempt is a number of the failed attempt (starting from 1)
+	Attempt int
+	// Err is an error of the failed attempt
+	Err error
+	// Wait is a time before the next attempt
+	Wait time.Duration
+}
+
+// DefaultMaxBackoff is the maximum wait time between attempts if RetryPolicy.MaxBackoff is zero
+const DefaultMaxBackoff = time.Minute
+
+// DefaultRetryPolicy returns a retry policy which retries transient failures up to 3 attempts:
+// HTTP 429, 500, 502, 503, 504, network errors and eBay system errors.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 200 * time.Millisecond,
+		MaxBackoff:  5 * time.Second,
+		Jitter:      0.2,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetrySystemErrors:    true,
+		RetryTransportErrors: true,
+		RespectRetryAfter:    true,
+	}
+}
+
+// RetryError is returned when the request failed after more than one attempt.
+// It wraps the error of the last attempt.
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+// Error implements error interface
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("after %d attempts: %s", e.Attempts, e.Err)
+}
+
+// Unwrap returns the error of the last attempt
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// notSentError is returned when the request failed before it was sent to eBay
+// (e.g. the token provider or the rate limiter failed). It does not change the message of the error.
+type notSentError struct {
+	err error
+}
+
+// Error implements error interface
+func (e *notSentError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the original error
+func (e *notSentError) Unwrap() error {
+	return e.err
+}
+
+// Attempts returns a number of attempts made before err was returned.
+// It is 0 for nil error and for errors returned before the request was sent to eBay
+// (e.g. *ValidationError or *QuotaExceededError); the number of attempts of a successful call
+// is in the Attempts field of its response.
+func Attempts(err error) int {
+	if err == nil {
+		return 0
+	}
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) {
+		return retryErr.Attempts
+	}
+	var notSent *notSentError
+	var validationErr *ValidationError
+	if errors.As(err, &notSent) || errors.As(err, &validationErr) {
+		return 0
+	}
+	return 1
+}
+
+// retryable checks if the failed attempt can be retried.
+// res is nil if the request was not sent or the response was not received.
+func (p RetryPolicy) retryable(res *http.Response, err error) bool {
+	if res == nil {
+		return p.RetryTransportErrors
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, status := range p.RetryableStatuses {
+		if apiErr.StatusCode == status {
+			return true
+		}
+	}
+	if apiErr.HasErrorCode(p.RetryableErrorCodes...) {
+		return true
+	}
+	return p.RetrySystemErrors && apiErr.HasErrorClassification(ErrorClassificationSystemError)
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// backoff returns wait time after the given failed attempt.
+// The wait time is limited by MaxBackoff (DefaultMaxBackoff if it is zero).
+func (p RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	limit := p.MaxBackoff
+	if limit <= 0 {
+		limit = DefaultMaxBackoff
+	}
+	if p.RespectRetryAfter && res != nil {
+		if d, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			if d > limit {
+				d = limit
+			}
+			return d
+		}
+	}
+	d := p.BaseBackoff
+	// d stays below 2*limit, so it does not overflow
+	for i := 1; i < attempt && d < limit; i++ {
+		d *= 2
+	}
+	if d > limit {
+		d = limit
+	}
+	if p.Jitter > 0 {
+		jitterMu.Lock()
+		r := jitterRand.Float64()
+		jitterMu.Unlock()
+		d -= time.Duration(float64(d) * p.Jitter * r)
+	}
+	return d
+}
+
+// parseRetryAfter parses Retry-After header value (delay in seconds or HTTP date)
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	d := time.Until(t)
+	if d < 0 {
+		d = 0
+	}
+	return d, true
+}
@@ -0,0 +1,243 @@
+package shopping
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSystemErrorResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<GeteBayTimeResponse xmlns="urn:ebay:apis:eBLBaseComponents">
+  <Ack>Failure</Ack>
+  <Errors>
+    <ShortMessage>Internal error to the application.</ShortMessage>
+    <ErrorCode>10.1</ErrorCode>
+    <SeverityCode>Error</SeverityCode>
+    <ErrorClassification>SystemError</ErrorClassification>
+  </Errors>
+</GeteBayTimeResponse>`
+
+// newFlakyServer starts test server which responds with failures first and then with success
+func newFlakyServer(failures int64, failure func(w http.ResponseWriter), calls *int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt64(calls, 1) <= failures {
+			failure(w)
+			return
+		}
+		_, _ = w.Write([]byte(testTimeResponse))
+	}))
+}
+
+func testRetryPolicy() RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.BaseBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	return p
+}
+
+func TestRetryPolicy_RetriesTransientFailures(t *testing.T) {
+	tests := []struct {
+		name    string
+		failure func(w http.ResponseWriter)
+	}{
+		{
+			name: "503",
+			failure: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+		},
+		{
+			name: "system error",
+			failure: func(w http.ResponseWriter) {
+				_, _ = w.Write([]byte(testSystemErrorResponse))
+			},
+		},
+		{
+			name: "connection reset",
+			failure: func(w http.ResponseWriter) {
+				conn, _, _ := w.(http.Hijacker).Hijack()
+				_ = conn.Close()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int64
+			server := newFlakyServer(2, tt.failure, &calls)
+			defer server.Close()
+
+			var events []RetryEvent
+			policy := testRetryPolicy()
+			policy.OnRetry = func(event RetryEvent) {
+				events = append(events, event)
+			}
+			service := NewService("").WithEndpoint(server.URL).WithRetryPolicy(policy)
+
+			res, err := service.NewGeteBayTimeRequest().Execute()
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, AckSuccess, res.Ack)
+			assert.Equal(t, 3, res.Attempts)
+			assert.Equal(t, int64(3), atomic.LoadInt64(&calls))
+			if assert.Len(t, events, 2) {
+				assert.Equal(t, 1, events[0].Attempt)
+				assert.Equal(t, 2, events[1].Attempt)
+				assert.Equal(t, OperationGeteBayTime, events[1].Operation)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_GivesUp(t *testing.T) {
+	var calls int64
+	server := newFlakyServer(10, func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusBadGateway)
+	}, &calls)
+	defer server.Close()
+
+	service := NewService("").WithEndpoint(server.URL).WithRetryPolicy(testRetryPolicy())
+	_, err := service.NewGeteBayTimeRequest().Execute()
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 3, Attempts(err))
+	assert.Equal(t, int64(3), atomic.LoadInt64(&calls))
+}
+
+func TestRetryPolicy_NotRetryable(t *testing.T) {
+	var calls int64
+	server := newFlakyServer(10, func(w http.ResponseWriter) {
+		_, _ = w.Write([]byte(testInvalidItemResponse))
+	}, &calls)
+	defer server.Close()
+
+	service := NewService("").WithEndpoint(server.URL).WithRetryPolicy(testRetryPolicy())
+	_, err := service.NewGetSingleItemRequest().WithItemID("1").Execute()
+	assert.True(t, IsInvalidItemID(err))
+	assert.Equal(t, 1, Attempts(err))
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+}
+
+func TestRetryPolicy_RetryAfterAndCancellation(t *testing.T) {
+	var calls int64
+	server := newFlakyServer(10, func(w http.ResponseWriter) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}, &calls)
+	defer server.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxBackoff = time.Minute
+	service := NewService("").WithEndpoint(server.URL).WithRetryPolicy(policy)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := service.NewGeteBayTimeRequest().ExecuteContext(ctx)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{
+		BaseBackoff: 100 * time.Millisecond,
+		MaxBackoff:  time.Second,
+	}
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1, nil))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2, nil))
+	assert.Equal(t, 800*time.Millisecond, p.backoff(4, nil))
+	assert.Equal(t, time.Second, p.backoff(10, nil))
+
+	p.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		d := p.backoff(2, nil)
+		assert.True(t, d > 100*time.Millisecond && d <= 200*time.Millisecond, "got %v", d)
+	}
+
+	// no overflow without MaxBackoff
+	p = RetryPolicy{BaseBackoff: time.Second, MaxAttempts: 100}
+	assert.Equal(t, DefaultMaxBackoff, p.backoff(99, nil))
+
+	// Retry-After is limited too
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	p.RespectRetryAfter = true
+	assert.Equal(t, DefaultMaxBackoff, p.backoff(1, res))
+	p.MaxBackoff = 5 * time.Second
+	assert.Equal(t, 5*time.Second, p.backoff(1, res))
+
+	d, ok := parseRetryAfter("7")
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, d)
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
+
+// failingTokenProvider is a TokenProvider which always fails
+type failingTokenProvider struct{}
+
+func (failingTokenProvider) Token(_ context.Context) (string, time.Time, error) {
+	return "", time.Time{}, errors.New("no token")
+}
+
+func TestAttempts_NotSent(t *testing.T) {
+	var calls int64
+	newService := func() *Service {
+		return NewService("").
+			WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, &calls)).
+			WithRetryPolicy(testRetryPolicy())
+	}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name    string
+		execute func() error
+	}{
+		{
+			name: "validation",
+			execute: func() error {
+				_, err := newService().NewFindProductsRequest().Execute()
+				return err
+			},
+		},
+		{
+			name: "quota",
+			execute: func() error {
+				service := newService().WithCallQuota(CallQuota{Limit: 1})
+				_, _ = service.NewGeteBayTimeRequest().Execute()
+				atomic.StoreInt64(&calls, 0)
+				_, err := service.NewGeteBayTimeRequest().Execute()
+				return err
+			},
+		},
+		{
+			name: "token",
+			execute: func() error {
+				_, err := newService().WithTokenProvider(failingTokenProvider{}).NewGeteBayTimeRequest().Execute()
+				return err
+			},
+		},
+		{
+			name: "rate limiter",
+			execute: func() error {
+				service := newService().WithRateLimiter(NewTokenBucket(0, 1))
+				_, _ = service.NewGeteBayTimeRequest().Execute()
+				atomic.StoreInt64(&calls, 0)
+				_, err := service.NewGeteBayTimeRequest().ExecuteContext(cancelled)
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			atomic.StoreInt64(&calls, 0)
+			err := tt.execute()
+			assert.Error(t, err)
+			assert.Equal(t, 0, Attempts(err))
+			assert.Equal(t, int64(0), atomic.LoadInt64(&calls))
+		})
+	}
+}
@@ -1,17 +1,48 @@
 package shopping
 
 import (
-	"github.com/go-resty/resty/v2"
+	"encoding/xml"
+	"net/http"
+	"sync"
 	"time"
+
+	"github.com/go-resty/resty/v2"
 )
 
 // Service represents Ebay Shopping API service
+//
+// Service owns a single HTTP client (and therefore a single connection pool),
+// which is shared by all requests created by the service.
 type Service struct {
-	version   string
-	endpoint  string
-	siteID    string
-	xIAFToken string
-	timeout   time.Duration
+	version  string
+	endpoint string
+	siteID   string
+	// tokenProvider provides IAF token for every call. It is guarded by tokenMu,
+	// because it can be changed while requests are executed.
+	tokenMu       sync.RWMutex
+	tokenProvider TokenProvider
+	timeout       time.Duration
+	// transport is a pooled transport created by the service which is configured by WithMaxIdleConns etc.
+	// It is nil if the service uses a caller-supplied client or transport.
+	transport   *http.Transport
+	httpClient  *http.Client
+	client      *resty.Client
+	retryPolicy RetryPolicy
+	// rateLimiter limits all calls, operationLimiters limit calls of particular operations
+	rateLimiter       RateLimiter
+	operationLimiters map[EbayOperation]RateLimiter
+	quota             *quotaCounter
+	batchConcurrency  int
+	encoding          Encoding
+	// nameValue makes requests be sent as GET with URL parameters
+	nameValue bool
+	cache     *responseCache
+	// dedup makes concurrent identical requests share one call
+	dedup       bool
+	flights     *flightGroup
+	middlewares []Middleware
+	metrics     Metrics
+	tracer      Tracer
 }
 
 // NewService creates new Ebay Shopping service
@@ -20,12 +51,33 @@ type Service struct {
 // Default GlobalID: SiteIDEbayUS (0)
 // Default Page Limit: DefaultItemsPerPage (100)
 // Default timeout for requests: 10 seconds
+// Default connection pool: DefaultMaxIdleConns, DefaultMaxIdleConnsPerHost, DefaultIdleConnTimeout
+// Default encoding: EncodingXML
 func NewService(xIAFToken string) *Service {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = DefaultMaxIdleConns
+	transport.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	transport.IdleConnTimeout = DefaultIdleConnTimeout
 	s := &Service{
-		version:   EbayShoppingAPIVersion,
-		xIAFToken: xIAFToken,
-		timeout:   10 * time.Second,
+		version:       EbayShoppingAPIVersion,
+		tokenProvider: StaticToken(xIAFToken),
+		timeout:       10 * time.Second,
+		transport:     transport,
+		// retries are disabled by default
+		retryPolicy:       RetryPolicy{MaxAttempts: 1},
+		operationLimiters: make(map[EbayOperation]RateLimiter),
+		quota:             newQuotaCounter(CallQuota{}),
+		batchConcurrency:  DefaultBatchConcurrency,
+		encoding:          EbayRequestDataFormat,
+		cache:             newResponseCache(),
+		flights:           newFlightGroup(),
+		metrics:           noopMetrics{},
 	}
+	s.httpClient = &http.Client{
+		Transport: transport,
+		Timeout:   s.timeout,
+	}
+	s.client = s.newHTTPClient()
 	s.WithEndpoint(EbayEndpointProduction)
 	s.WithSiteID(SiteIDEbayUS)
 	return s
@@ -33,55 +85,296 @@ func NewService(xIAFToken string) *Service {
 
 // WithEndpoint changes endpoint for service
 // You can add your own endpoint (for tests purposes)
+// Requests which are already created keep the previous endpoint.
 func (s *Service) WithEndpoint(endpoint string) *Service {
 	s.endpoint = endpoint
 	return s
 }
 
 // WithSiteID changes site for search
+// Requests which are already created keep the previous site.
 func (s *Service) WithSiteID(siteID SiteID) *Service {
 	s.siteID = string(siteID)
 	return s
 }
 
 // WithTimeout changes default timeout for search requests
+// It also applies if the service uses http.Client supplied by WithHTTPClient; the supplied client is not changed.
 func (s *Service) WithTimeout(timeout time.Duration) *Service {
 	s.timeout = timeout
+	s.httpClient.Timeout = timeout
 	return s
 }
 
 // WithToken changes IAFToken for service
+// It is safe to call WithToken while requests are executed, the token is used by all subsequent calls.
 func (s *Service) WithToken(xIAFToken string) *Service {
-	s.xIAFToken = xIAFToken
+	return s.WithTokenProvider(StaticToken(xIAFToken))
+}
+
+// WithTokenProvider makes the service get IAF token from the provider on every call,
+// e.g. from ClientCredentialsProvider which refreshes expiring application tokens.
+// If the provider implements TokenInvalidator, calls rejected because of expired or invalid token
+// are retried once with a new token. Nil is ignored.
+func (s *Service) WithTokenProvider(provider TokenProvider) *Service {
+	if provider != nil {
+		s.tokenMu.Lock()
+		s.tokenProvider = provider
+		s.tokenMu.Unlock()
+	}
+	return s
+}
+
+// getTokenProvider returns the current token provider
+func (s *Service) getTokenProvider() TokenProvider {
+	s.tokenMu.RLock()
+	defer s.tokenMu.RUnlock()
+	return s.tokenProvider
+}
+
+// WithHTTPClient makes the service send all requests using the given http.Client.
+// It allows to use custom proxies, mTLS settings or test round-trippers.
+// Timeout of the given client is used instead of the service timeout.
+// The service uses a copy of the client, so the client itself is never changed by the service.
+// Connection pool options (WithMaxIdleConns etc.) are not applied to the transport of the client.
+func (s *Service) WithHTTPClient(client *http.Client) *Service {
+	if client == nil {
+		return s
+	}
+	c := *client
+	s.httpClient = &c
+	s.transport = nil
+	s.timeout = client.Timeout
+	s.client = s.newHTTPClient()
+	return s
+}
+
+// WithTransport makes the service send all requests using the given http.RoundTripper.
+// Connection pool options (WithMaxIdleConns etc.) are not applied to the transport,
+// so it can be shared (e.g. http.DefaultTransport).
+func (s *Service) WithTransport(transport http.RoundTripper) *Service {
+	if transport == nil {
+		return s
+	}
+	s.httpClient.Transport = transport
+	s.transport = nil
+	return s
+}
+
+// WithEncoding changes data format of requests and responses of the service.
+// EncodingJSON is cheaper to parse for large responses (e.g. GetMultipleItems).
+// Unknown encodings are ignored.
+func (s *Service) WithEncoding(encoding Encoding) *Service {
+	if encoding == EncodingXML || encoding == EncodingJSON {
+		s.encoding = encoding
+	}
+	return s
+}
+
+// WithNameValueRequests makes the service send requests as GET with URL parameters (name-value format)
+// instead of POST with XML or JSON body. Such requests can be cached by CDN or HTTP cache by URL.
+// Call name, API version, site ID and response encoding are added to the URL parameters.
+// Responses are decoded according to WithEncoding.
+func (s *Service) WithNameValueRequests(enabled bool) *Service {
+	s.nameValue = enabled
+	return s
+}
+
+// WithCache makes the service cache responses of successful calls (Ack Success or Warning).
+// Responses are cached by endpoint URL, API version, request body, site ID and encoding for TTL of the operation
+// (see DefaultCacheTTLs and WithCacheTTL). Cached calls are not counted in rate limits and call quota.
+// Nil disables caching.
+func (s *Service) WithCache(cache Cache) *Service {
+	s.cache.cache = cache
+	return s
+}
+
+// WithCacheTTL changes TTL of cached responses of the operation. Zero disables caching of the operation.
+func (s *Service) WithCacheTTL(operation EbayOperation, ttl time.Duration) *Service {
+	s.cache.setTTL(operation, ttl)
+	return s
+}
+
+// CacheStats returns cache hits, misses and stored responses per operation
+func (s *Service) CacheStats() map[EbayOperation]CacheStats {
+	return s.cache.snapshot()
+}
+
+// WithRequestDeduplication makes concurrent identical requests (same operation, site ID and request body)
+// share one call to eBay. Every caller gets its own copy of the response and the same error.
+// Cancellation of a caller's context does not affect other callers;
+// the shared call is canceled when all callers have given up.
+func (s *Service) WithRequestDeduplication(enabled bool) *Service {
+	s.dedup = enabled
+	return s
+}
+
+// WithMiddleware appends middlewares to the service middleware chain.
+// The first middleware is the outermost one: it gets the call first and the result last.
+// Middlewares are applied to all operations.
+func (s *Service) WithMiddleware(middlewares ...Middleware) *Service {
+	s.middlewares = append(s.middlewares, middlewares...)
+	return s
+}
+
+// WithLogger makes the service log every call to eBay using logger (e.g. *slog.Logger).
+// IAF token and contact data of sellers are redacted according to options.
+// See NewLoggingMiddleware.
+func (s *Service) WithLogger(logger Logger, options LogOptions) *Service {
+	return s.WithMiddleware(NewLoggingMiddleware(logger, options))
+}
+
+// WithMetrics makes the service report metrics of calls, cache lookups, retries and rate limiter waits.
+// Nil disables metrics.
+func (s *Service) WithMetrics(metrics Metrics) *Service {
+	if metrics == nil {
+		metrics = noopMetrics{}
+	}
+	s.metrics = metrics
+	return s
+}
+
+// WithTracer makes every Execute and GetPage call of the service produce a span
+// which is a child of the span in the call context. Nil disables tracing.
+func (s *Service) WithTracer(tracer Tracer) *Service {
+	s.tracer = tracer
+	return s
+}
+
+// WithRetryPolicy changes retry policy for all requests of the service.
+// By default requests are not retried. Use DefaultRetryPolicy for sane defaults.
+func (s *Service) WithRetryPolicy(policy RetryPolicy) *Service {
+	s.retryPolicy = policy
+	return s
+}
+
+// WithRateLimiter limits the rate of all calls of the service.
+// Nil removes the limiter.
+func (s *Service) WithRateLimiter(limiter RateLimiter) *Service {
+	s.rateLimiter = limiter
+	return s
+}
+
+// WithRateLimit limits all calls of the service to perSecond calls per second
+// with bursts of up to burst calls (token bucket).
+func (s *Service) WithRateLimit(perSecond float64, burst int) *Service {
+	return s.WithRateLimiter(NewTokenBucket(perSecond, burst))
+}
+
+// WithOperationRateLimiter limits the rate of calls of the operation.
+// It is applied in addition to the limiter set by WithRateLimiter. Nil removes the limiter.
+func (s *Service) WithOperationRateLimiter(operation EbayOperation, limiter RateLimiter) *Service {
+	if limiter == nil {
+		delete(s.operationLimiters, operation)
+		return s
+	}
+	s.operationLimiters[operation] = limiter
+	return s
+}
+
+// WithCallQuota sets daily call quota of the service.
+// Calls are counted per operation per eBay day even without quota, see CallCounts.
+func (s *Service) WithCallQuota(quota CallQuota) *Service {
+	s.quota.setQuota(quota)
+	return s
+}
+
+// CallCounts returns numbers of calls per operation made by the service during the current eBay day
+func (s *Service) CallCounts() map[EbayOperation]int64 {
+	return s.quota.usage()
+}
+
+// WithBatchConcurrency changes the maximum number of concurrent calls made by batch methods
+// (e.g. GetItems). Default is DefaultBatchConcurrency.
+func (s *Service) WithBatchConcurrency(n int) *Service {
+	if n < 1 {
+		n = 1
+	}
+	s.batchConcurrency = n
+	return s
+}
+
+// WithMaxIdleConns changes the maximum number of idle (keep-alive) connections
+// kept by the service connection pool. Zero means no limit.
+func (s *Service) WithMaxIdleConns(n int) *Service {
+	if s.transport != nil {
+		s.transport.MaxIdleConns = n
+	}
+	return s
+}
+
+// WithMaxIdleConnsPerHost changes the maximum number of idle (keep-alive) connections
+// kept by the service connection pool for eBay endpoint.
+func (s *Service) WithMaxIdleConnsPerHost(n int) *Service {
+	if s.transport != nil {
+		s.transport.MaxIdleConnsPerHost = n
+	}
+	return s
+}
+
+// WithMaxConnsPerHost limits the total number of connections (dialing, active and idle)
+// to eBay endpoint. Zero means no limit.
+func (s *Service) WithMaxConnsPerHost(n int) *Service {
+	if s.transport != nil {
+		s.transport.MaxConnsPerHost = n
+	}
+	return s
+}
+
+// WithIdleConnTimeout changes the maximum amount of time an idle (keep-alive) connection
+// remains in the service connection pool before closing itself. Zero means no limit.
+func (s *Service) WithIdleConnTimeout(timeout time.Duration) *Service {
+	if s.transport != nil {
+		s.transport.IdleConnTimeout = timeout
+	}
 	return s
 }
 
 // creates new http client (resty)
+// Headers are applied per call, see RequestBasic.header.
 func (s *Service) newHTTPClient() *resty.Client {
-	return resty.New().
-		SetHeader("X-EBAY-API-VERSION", s.version).
-		SetHeader("X-EBAY-API-IAF-TOKEN", s.xIAFToken).
-		SetHeader("X-EBAY-API-REQUEST-ENCODING", EbayRequestDataFormat).
-		SetHeader("X-EBAY-API-RESPONSE-ENCODING", EbayResponseDataFormat).
-		SetHeader("X-EBAY-API-SITE-ID", s.siteID).
-		SetTimeout(s.timeout)
+	return resty.NewWithClient(s.httpClient)
+}
+
+// unmarshal decodes response body according to the service encoding
+func (s *Service) unmarshal(data []byte, v interface{}) error {
+	if s.encoding == EncodingJSON {
+		return unmarshalJSON(data, v)
+	}
+	return xml.Unmarshal(data, v)
+}
+
+// unmarshalEnvelope decodes only Ack, CorrelationID and Errors of the response according to the service encoding
+func (s *Service) unmarshalEnvelope(data []byte, rs *responseStandard) error {
+	if s.encoding == EncodingJSON {
+		return unmarshalJSONEnvelope(data, rs)
+	}
+	return unmarshalXMLEnvelope(data, rs)
+}
+
+// creates RequestBasic bound to the service
+// Endpoint, site ID and API version of the service are copied into the request.
+func (s *Service) newRequestBasic(operation EbayOperation) RequestBasic {
+	return RequestBasic{
+		URL:       s.endpoint,
+		service:   s,
+		operation: operation,
+		siteID:    s.siteID,
+		version:   s.version,
+	}
 }
 
 // NewFindProductsRequest creates new FindProductsRequest
 func (s *Service) NewFindProductsRequest() *FindProductsRequest {
 	req := FindProductsRequest{}
-	req.Client = s.newHTTPClient().
-		SetHeader("X-EBAY-API-CALL-NAME", string(OperationFindProducts))
-	req.URL = s.endpoint
+	req.RequestBasic = s.newRequestBasic(OperationFindProducts)
 	return &req
 }
 
 // NewGetCategoryInfoRequest creates new GetCategoryInfoRequest
 func (s *Service) NewGetCategoryInfoRequest() *GetCategoryInfoRequest {
 	req := GetCategoryInfoRequest{}
-	req.Client = s.newHTTPClient().
-		SetHeader("X-EBAY-API-CALL-NAME", string(OperationGetCategoryInfo))
-	req.URL = s.endpoint
+	req.RequestBasic = s.newRequestBasic(OperationGetCategoryInfo)
 	return &req
 }
 
@@ -90,62 +383,48 @@ func (s *Service) NewGetCategoryInfoRequestWithCategory(categoryID string) *GetC
 	req := GetCategoryInfoRequest{
 		CategoryID: categoryID,
 	}
-	req.Client = s.newHTTPClient().
-		SetHeader("X-EBAY-API-CALL-NAME", string(OperationGetCategoryInfo))
-	req.URL = s.endpoint
+	req.RequestBasic = s.newRequestBasic(OperationGetCategoryInfo)
 	return &req
 }
 
 // NewGeteBayTimeRequest creates new GeteBayTimeRequest
 func (s *Service) NewGeteBayTimeRequest() *GeteBayTimeRequest {
 	req := GeteBayTimeRequest{}
-	req.Client = s.newHTTPClient().
-		SetHeader("X-EBAY-API-CALL-NAME", string(OperationGeteBayTime))
-	req.URL = s.endpoint
+	req.RequestBasic = s.newRequestBasic(OperationGeteBayTime)
 	return &req
 }
 
 // NewGetItemStatusRequest creates new GetItemStatusRequest
 func (s *Service) NewGetItemStatusRequest() *GetItemStatusRequest {
 	req := GetItemStatusRequest{}
-	req.Client = s.newHTTPClient().
-		SetHeader("X-EBAY-API-CALL-NAME", string(OperationGetItemStatus))
-	req.URL = s.endpoint
+	req.RequestBasic = s.newRequestBasic(OperationGetItemStatus)
 	return &req
 }
 
 // NewGetMultipleItemsRequest creates new GetMultipleItemsRequest
 func (s *Service) NewGetMultipleItemsRequest() *GetMultipleItemsRequest {
 	req := GetMultipleItemsRequest{}
-	req.Client = s.newHTTPClient().
-		SetHeader("X-EBAY-API-CALL-NAME", string(OperationGetMultipleItems))
-	req.URL = s.endpoint
+	req.RequestBasic = s.newRequestBasic(OperationGetMultipleItems)
 	return &req
 }
 
 // NewGetShippingCostsRequest creates new GetShippingCostsRequest
 func (s *Service) NewGetShippingCostsRequest() *GetShippingCostsRequest {
 	req := GetShippingCostsRequest{}
-	req.Client = s.newHTTPClient().
-		SetHeader("X-EBAY-API-CALL-NAME", string(OperationGetShippingCosts))
-	req.URL = s.endpoint
+	req.RequestBasic = s.newRequestBasic(OperationGetShippingCosts)
 	return &req
 }
 
 // NewGetSingleItemRequest creates new GetSingleItemRequest
 func (s *Service) NewGetSingleItemRequest() *GetSingleItemRequest {
 	req := GetSingleItemRequest{}
-	req.Client = s.newHTTPClient().
-		SetHeader("X-EBAY-API-CALL-NAME", string(OperationGetSingleItem))
-	req.URL = s.endpoint
+	req.RequestBasic = s.newRequestBasic(OperationGetSingleItem)
 	return &req
 }
 
 // NewGetUserProfileRequest creates new GetUserProfileRequest
 func (s *Service) NewGetUserProfileRequest() *GetUserProfileRequest {
 	req := GetUserProfileRequest{}
-	req.Client = s.newHTTPClient().
-		SetHeader("X-EBAY-API-CALL-NAME", string(OperationGetUserProfile))
-	req.URL = s.endpoint
+	req.RequestBasic = s.newRequestBasic(OperationGetUserProfile)
 	return &req
 }
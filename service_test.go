@@ -0,0 +1,178 @@
+package shopping
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newCountingServer starts test server which counts new TCP connections
+func newCountingServer(handler http.Handler, conns *int64) *httptest.Server {
+	server := httptest.NewUnstartedServer(handler)
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(conns, 1)
+		}
+	}
+	server.Start()
+	return server
+}
+
+func TestService_SharedClient(t *testing.T) {
+	var conns int64
+	server := newCountingServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token", r.Header.Get("X-EBAY-API-IAF-TOKEN"))
+		assert.Equal(t, string(SiteIDEbayDE), r.Header.Get("X-EBAY-API-SITE-ID"))
+		assert.Equal(t, EbayShoppingAPIVersion, r.Header.Get("X-EBAY-API-VERSION"))
+		_, _ = w.Write([]byte(testTimeResponse))
+	}), &conns)
+	defer server.Close()
+
+	service := NewService("token").WithEndpoint(server.URL).WithSiteID(SiteIDEbayDE)
+	for i := 0; i < 20; i++ {
+		_, err := service.NewGeteBayTimeRequest().Execute()
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(&conns))
+}
+
+func TestService_PerRequestHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "new-token", r.Header.Get("X-EBAY-API-IAF-TOKEN"))
+		assert.Equal(t, string(OperationGetUserProfile), r.Header.Get("X-EBAY-API-CALL-NAME"))
+		_, _ = w.Write([]byte(testTimeResponse))
+	}))
+	defer server.Close()
+
+	service := NewService("old-token").WithEndpoint(server.URL)
+	request := service.NewGetUserProfileRequest().WithUserID("user")
+	service.WithToken("new-token")
+	_, err := request.Execute()
+	assert.NoError(t, err)
+}
+
+// roundTripperFunc allows to use a function as http.RoundTripper
+type roundTripperFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// newStaticTransport creates http.RoundTripper which responds with the given body
+// and counts calls in calls (if not nil)
+func newStaticTransport(status int, body string, calls *int64) http.RoundTripper {
+	return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if calls != nil {
+			atomic.AddInt64(calls, 1)
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"text/xml"}},
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Request:    r,
+		}, nil
+	})
+}
+
+func TestService_WithTransport(t *testing.T) {
+	var calls int64
+	service := NewService("").
+		WithEndpoint("http://ebay.invalid/shopping").
+		WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, &calls))
+
+	res, err := service.NewGeteBayTimeRequest().Execute()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Success", res.Ack)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+}
+
+func TestService_WithHTTPClient(t *testing.T) {
+	var calls int64
+	client := &http.Client{Transport: newStaticTransport(http.StatusOK, testTimeResponse, &calls)}
+	service := NewService("").
+		WithEndpoint("http://ebay.invalid/shopping").
+		WithHTTPClient(client)
+
+	_, err := service.NewGeteBayTimeRequest().Execute()
+	assert.NoError(t, err)
+	_, err = service.NewGetSingleItemRequest().WithItemID("1").Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), atomic.LoadInt64(&calls))
+}
+
+func TestService_CallerClientIsNotChanged(t *testing.T) {
+	transport := &http.Transport{MaxIdleConnsPerHost: 7}
+	client := &http.Client{Transport: transport, Timeout: time.Minute}
+	rt := newStaticTransport(http.StatusOK, testTimeResponse, nil)
+	service := NewService("").
+		WithHTTPClient(client).
+		WithTimeout(time.Second).
+		WithTransport(rt).
+		WithMaxIdleConnsPerHost(1)
+	assert.Same(t, transport, client.Transport)
+	assert.Equal(t, time.Minute, client.Timeout)
+	assert.Equal(t, 7, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, time.Second, service.httpClient.Timeout)
+
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+	perHost := defaultTransport.MaxIdleConnsPerHost
+	NewService("").WithTransport(http.DefaultTransport).WithMaxIdleConnsPerHost(perHost + 1).WithIdleConnTimeout(0)
+	assert.Equal(t, perHost, defaultTransport.MaxIdleConnsPerHost)
+}
+
+func BenchmarkService_ConnectionReuse(b *testing.B) {
+	var conns int64
+	server := newCountingServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testTimeResponse))
+	}), &conns)
+	defer server.Close()
+
+	service := NewService("").WithEndpoint(server.URL)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := service.NewGeteBayTimeRequest().Execute(); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+	b.ReportMetric(float64(atomic.LoadInt64(&conns)), "conns")
+}
+
+func TestService_ConcurrentReconfiguration(t *testing.T) {
+	service := NewService("token").WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, nil))
+	request := service.NewGeteBayTimeRequest()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				_, err := request.ExecuteContext(context.Background())
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	for j := 0; j < 20; j++ {
+		service.WithToken(fmt.Sprintf("token-%d", j))
+		service.WithSiteID(SiteIDEbayDE)
+	}
+	wg.Wait()
+	// the request keeps the site of the service at the time it was created
+	assert.Equal(t, string(SiteIDEbayUS), request.siteID)
+}
@@ -0,0 +1,173 @@
+package shopping
+
+import (
+	"context"
+	"fmt"
+)
+
+// ShippingDestination is a destination of Service.GetShippingCostsMatrix
+type ShippingDestination struct {
+	CountryCode CountryCode
+	PostalCode  string
+	// Quantity of items to be shipped together. Zero means 1.
+	Quantity int
+}
+
+// ShippingQuote is a cost and delivery estimation of one shipping service to a destination
+type ShippingQuote struct {
+	ShippingServiceName string
+	International       bool
+	// Cost includes shipping of all items, import charge and required insurance
+	Cost                     Money
+	EstimatedDeliveryMaxTime EbayTime
+	// ShippingTimeMax is the maximum number of business days of domestic shipping
+	ShippingTimeMax int
+}
+
+// ShippingMatrixEntry is a result of Service.GetShippingCostsMatrix for one destination
+type ShippingMatrixEntry struct {
+	Destination ShippingDestination
+	Response    GetShippingCostsResponse
+	// Quotes contains all shipping services available to the destination
+	Quotes   []ShippingQuote
+	Cheapest *ShippingQuote
+	Fastest  *ShippingQuote
+	// Excluded is true if the destination country is in ExcludeShipToLocations of the listing
+	Excluded bool
+	// RateError is ShippingRateErrorMessage returned by eBay
+	RateError string
+	// Err is set if the call for the destination failed
+	Err error
+}
+
+// ShippingMatrix is a result of Service.GetShippingCostsMatrix
+type ShippingMatrix struct {
+	ItemID string
+	// Entries are in the order of destinations
+	Entries []ShippingMatrixEntry
+}
+
+// GetShippingCostsMatrix retrieves shipping costs of the item to every destination using GetShippingCosts calls
+// with IncludeDetails. Calls are executed concurrently (see Service.WithBatchConcurrency) and are subject
+// to the Service rate limits and quota.
+//
+// If some calls failed, ShippingMatrixEntry.Err is set for them and an error wrapping the first failure
+// is returned together with the matrix.
+func (s *Service) GetShippingCostsMatrix(ctx context.Context, itemID string, destinations []ShippingDestination) (ShippingMatrix, error) {
+	matrix := ShippingMatrix{
+		ItemID:  itemID,
+		Entries: make([]ShippingMatrixEntry, len(destinations)),
+	}
+	errs := s.runChunks(ctx, len(destinations), func(ctx context.Context, i int) error {
+		d := destinations[i]
+		quantity := d.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+		res, err := s.NewGetShippingCostsRequest().
+			WithItemID(itemID).
+			WithDestinationCountryCode(d.CountryCode).
+			WithDestinationPostalCode(d.PostalCode).
+			WithQuantitySold(quantity).
+			WithIncludeDetails(true).
+			ExecuteContext(ctx)
+		if err != nil && !isAPIFailure(err) {
+			return err
+		}
+		entry, entryErr := newShippingMatrixEntry(d, quantity, res)
+		matrix.Entries[i] = entry
+		if err != nil {
+			return err
+		}
+		return entryErr
+	})
+
+	var first error
+	failed := 0
+	for i, err := range errs {
+		matrix.Entries[i].Destination = destinations[i]
+		if err == nil {
+			continue
+		}
+		matrix.Entries[i].Err = err
+		if first == nil {
+			first = err
+		}
+		failed++
+	}
+	if first != nil {
+		return matrix, fmt.Errorf("%s: %d of %d destination(s) failed: %w",
+			OperationGetShippingCosts, failed, len(destinations), first)
+	}
+	return matrix, nil
+}
+
+// newShippingMatrixEntry creates ShippingMatrixEntry from the response
+func newShippingMatrixEntry(d ShippingDestination, quantity int, res GetShippingCostsResponse) (ShippingMatrixEntry, error) {
+	details := res.ShippingDetails
+	entry := ShippingMatrixEntry{
+		Destination: d,
+		Response:    res,
+		RateError:   details.ShippingRateErrorMessage,
+	}
+	for _, location := range details.ExcludeShipToLocations {
+		if location == string(d.CountryCode) {
+			entry.Excluded = true
+		}
+	}
+	options := landedCostOptions(res)
+	for i, o := range options {
+		cost := o.Shipping
+		additional, err := o.AdditionalShipping.Mul(quantity - 1)
+		if err != nil {
+			return entry, fmt.Errorf("shipping service %q: %w", o.ShippingServiceName, err)
+		}
+		for _, m := range []Money{additional, o.Import, o.Insurance} {
+			if cost, err = cost.Add(m); err != nil {
+				return entry, fmt.Errorf("shipping service %q: %w", o.ShippingServiceName, err)
+			}
+		}
+		q := ShippingQuote{ShippingServiceName: o.ShippingServiceName, Cost: cost}
+		// landedCostOptions returns domestic options first, then international ones
+		if domestic := details.ShippingServiceOptions; i < len(domestic) {
+			q.EstimatedDeliveryMaxTime = domestic[i].EstimatedDeliveryMaxTime
+			q.ShippingTimeMax = domestic[i].ShippingTimeMax
+		} else if international := details.InternationalShippingServiceOptions; i-len(domestic) < len(international) {
+			q.International = true
+			q.EstimatedDeliveryMaxTime = international[i-len(domestic)].EstimatedDeliveryMaxTime
+		}
+		entry.Quotes = append(entry.Quotes, q)
+	}
+	for i := range entry.Quotes {
+		q := &entry.Quotes[i]
+		if entry.Cheapest == nil {
+			entry.Cheapest = q
+		} else if cmp, err := q.Cost.Cmp(entry.Cheapest.Cost); err != nil {
+			return entry, fmt.Errorf("shipping service %q: %w", q.ShippingServiceName, err)
+		} else if cmp < 0 {
+			entry.Cheapest = q
+		}
+		if entry.Fastest == nil || fasterQuote(q, entry.Fastest) {
+			entry.Fastest = q
+		}
+	}
+	return entry, nil
+}
+
+// fasterQuote checks if quote a is delivered faster than b. Quotes with estimated delivery time are
+// preferred over quotes without it. Ties are resolved by cost.
+func fasterQuote(a, b *ShippingQuote) bool {
+	aTime, bTime := a.EstimatedDeliveryMaxTime.Time, b.EstimatedDeliveryMaxTime.Time
+	switch {
+	case !aTime.IsZero() && !bTime.IsZero() && !aTime.Equal(bTime):
+		return aTime.Before(bTime)
+	case aTime.IsZero() != bTime.IsZero():
+		return !aTime.IsZero()
+	case a.ShippingTimeMax > 0 && b.ShippingTimeMax > 0 && a.ShippingTimeMax != b.ShippingTimeMax:
+		return a.ShippingTimeMax < b.ShippingTimeMax
+	case (a.ShippingTimeMax > 0) != (b.ShippingTimeMax > 0):
+		return a.ShippingTimeMax > 0
+	}
+	cmp, err := a.Cost.Cmp(b.Cost)
+	return err == nil && cmp < 0
+}
@@ -0,0 +1,94 @@
+package shopping
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_GetShippingCostsMatrix(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		req := GetShippingCostsRequest{}
+		if !assert.NoError(t, xml.Unmarshal(b, &req)) {
+			return
+		}
+		assert.Equal(t, "123", req.ItemID)
+		assert.True(t, req.IncludeDetails)
+
+		var details string
+		switch req.DestinationCountryCode {
+		case "US":
+			details = `<ShippingServiceOption><ShippingServiceName>Standard</ShippingServiceName>` +
+				`<ShippingServiceCost currencyID="USD">5.00</ShippingServiceCost>` +
+				`<ShippingServiceAdditionalCost currencyID="USD">2.00</ShippingServiceAdditionalCost>` +
+				`<ShippingTimeMax>7</ShippingTimeMax></ShippingServiceOption>` +
+				`<ShippingServiceOption><ShippingServiceName>Express</ShippingServiceName>` +
+				`<ShippingServiceCost currencyID="USD">10.00</ShippingServiceCost>` +
+				`<ShippingTimeMax>2</ShippingTimeMax></ShippingServiceOption>`
+		case "CA":
+			details = `<InternationalShippingServiceOption><ShippingServiceName>Intl</ShippingServiceName>` +
+				`<ShippingServiceCost currencyID="USD">20.00</ShippingServiceCost>` +
+				`<ImportCharge currencyID="USD">3.50</ImportCharge></InternationalShippingServiceOption>` +
+				`<ShippingRateErrorMessage>Rates are estimated</ShippingRateErrorMessage>`
+		case "RU":
+			details = `<ExcludeShipToLocation>RU</ExcludeShipToLocation>`
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `<GetShippingCostsResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>Success</Ack>`+
+			`<ShippingDetails>%s</ShippingDetails></GetShippingCostsResponse>`, details)
+	}))
+	defer server.Close()
+	service := NewService("").WithEndpoint(server.URL).WithBatchConcurrency(2)
+
+	destinations := []ShippingDestination{
+		{CountryCode: "US", PostalCode: "95125", Quantity: 3},
+		{CountryCode: "CA"},
+		{CountryCode: "RU"},
+		{CountryCode: "DE"},
+		{CountryCode: "UK"},
+	}
+	matrix, err := service.GetShippingCostsMatrix(context.Background(), "123", destinations)
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr), "got %v", err)
+	if !assert.Len(t, matrix.Entries, 5) {
+		return
+	}
+
+	us := matrix.Entries[0]
+	assert.NoError(t, us.Err)
+	assert.Equal(t, destinations[0], us.Destination)
+	if assert.NotNil(t, us.Cheapest) && assert.NotNil(t, us.Fastest) {
+		assert.Equal(t, "Express", us.Fastest.ShippingServiceName)
+		assert.Equal(t, "Standard", us.Cheapest.ShippingServiceName)
+		assert.Equal(t, NewMoney(900, "USD"), us.Cheapest.Cost)
+	}
+
+	ca := matrix.Entries[1]
+	assert.Equal(t, "Rates are estimated", ca.RateError)
+	if assert.NotNil(t, ca.Cheapest) {
+		assert.True(t, ca.Cheapest.International)
+		assert.Equal(t, NewMoney(2350, "USD"), ca.Cheapest.Cost)
+	}
+
+	ru := matrix.Entries[2]
+	assert.True(t, ru.Excluded)
+	assert.Nil(t, ru.Cheapest)
+
+	assert.Error(t, matrix.Entries[3].Err)
+	assert.Equal(t, destinations[3], matrix.Entries[3].Destination)
+	// unknown country code is rejected before the call
+	var validationErr *ValidationError
+	if assert.True(t, errors.As(matrix.Entries[4].Err, &validationErr)) {
+		assert.True(t, validationErr.HasField("DestinationCountryCode"))
+	}
+}
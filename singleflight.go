@@ -0,0 +1,96 @@
+package shopping
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// flightGroup coalesces concurrent identical calls into one call (see Service.WithRequestDeduplication)
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+// flight is a call in progress
+type flight struct {
+	done   chan struct{}
+	cancel context.CancelFunc
+	// waiters is the number of callers waiting for the result
+	waiters int
+	result  sent
+	err     error
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{calls: make(map[string]*flight)}
+}
+
+// do calls fn once for concurrent calls with the same key and returns its result to every caller.
+//
+// fn is not bound to the cancellation of any caller. Its context keeps the values of the first caller's ctx
+// (e.g. trace parent or request ID for middlewares and the logger), but it is canceled only when all callers
+// have given up, so cancellation of one caller does not affect the others. A caller whose ctx is done returns immediately
+// with an error wrapping ctx.Err().
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (sent, error)) (sent, error) {
+	g.mu.Lock()
+	f, ok := g.calls[key]
+	if !ok {
+		fctx, cancel := context.WithCancel(valueOnlyContext{ctx})
+		f = &flight{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = f
+		go func() {
+			f.result, f.err = fn(fctx)
+			g.mu.Lock()
+			g.forget(key, f)
+			g.mu.Unlock()
+			cancel()
+			close(f.done)
+		}()
+	}
+	f.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return f.result, f.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			// nobody waits for the result, new callers must not join the canceled call
+			f.cancel()
+			g.forget(key, f)
+		}
+		g.mu.Unlock()
+		return sent{}, fmt.Errorf("waiting for in-flight request: %w", ctx.Err())
+	}
+}
+
+// forget removes call f of the key. It must be called with g.mu held.
+func (g *flightGroup) forget(key string, f *flight) {
+	if g.calls[key] == f {
+		delete(g.calls, key)
+	}
+}
+
+// valueOnlyContext keeps the values of the parent context without its deadline and cancellation
+type valueOnlyContext struct {
+	context.Context
+}
+
+// Deadline implements context.Context. There is no deadline.
+func (valueOnlyContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done implements context.Context. The context is never canceled.
+func (valueOnlyContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err implements context.Context
+func (valueOnlyContext) Err() error {
+	return nil
+}
@@ -0,0 +1,102 @@
+package shopping
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_WithRequestDeduplication(t *testing.T) {
+	var calls int64
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&calls, 1)
+		<-release
+		_, _ = w.Write([]byte(`<GetSingleItemResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>Success</Ack>` +
+			`<Item><ItemID>1</ItemID></Item></GetSingleItemResponse>`))
+	}))
+	defer server.Close()
+	service := NewService("").WithEndpoint(server.URL).WithRequestDeduplication(true)
+
+	const n = 10
+	results := make([]GetSingleItemResponse, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := service.NewGetSingleItemRequest().WithItemID("1").ExecuteContext(context.Background())
+			assert.NoError(t, err)
+			results[i] = res
+		}(i)
+	}
+
+	// one of the callers gives up
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() {
+		_, err := service.NewGetSingleItemRequest().WithItemID("1").ExecuteContext(ctx)
+		canceled <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	err := <-canceled
+	assert.True(t, errors.Is(err, context.Canceled), "got %v", err)
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	for _, res := range results {
+		assert.Equal(t, "1", res.Item.ItemID)
+		assert.Equal(t, 1, res.Attempts)
+	}
+}
+
+func TestFlightGroup_AllCallersCanceled(t *testing.T) {
+	g := newFlightGroup()
+	started := make(chan struct{})
+	stopped := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	_, err := g.do(ctx, "key", func(ctx context.Context) (sent, error) {
+		close(started)
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		return sent{}, ctx.Err()
+	})
+	assert.True(t, errors.Is(err, context.Canceled), "got %v", err)
+	assert.Equal(t, context.Canceled, <-stopped)
+
+	// a new call is started for the key
+	res, err := g.do(context.Background(), "key", func(ctx context.Context) (sent, error) {
+		return sent{body: []byte("ok"), attempts: 1}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, sent{body: []byte("ok"), attempts: 1}, res)
+}
+
+// flightKey is a context key used by the tests
+type flightKey struct{}
+
+func TestFlightGroup_KeepsContextValues(t *testing.T) {
+	g := newFlightGroup()
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), flightKey{}, "request-1"), time.Minute)
+	defer cancel()
+	_, err := g.do(ctx, "key", func(ctx context.Context) (sent, error) {
+		assert.Equal(t, "request-1", ctx.Value(flightKey{}))
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+		return sent{}, nil
+	})
+	assert.NoError(t, err)
+}
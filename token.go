@@ -0,0 +1,195 @@
+package shopping
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenProvider provides IAF token for every call of Service (see Service.WithTokenProvider).
+// Token returns the token and its expiry time. Zero expiry means the token does not expire.
+// Implementations must be safe for concurrent use.
+type TokenProvider interface {
+	Token(ctx context.Context) (token string, expiry time.Time, err error)
+}
+
+// TokenInvalidator is implemented by token providers which can refresh tokens.
+// If eBay rejects a token as expired or invalid, Service calls InvalidateToken with the token
+// and retries the call once with a new token.
+type TokenInvalidator interface {
+	InvalidateToken(token string)
+}
+
+// StaticToken is a TokenProvider which always returns the same token
+type StaticToken string
+
+// Token implements TokenProvider
+func (t StaticToken) Token(_ context.Context) (string, time.Time, error) {
+	return string(t), time.Time{}, nil
+}
+
+/*
+==============================================================
+*/
+
+// ClientCredentialsProvider is a TokenProvider which mints application tokens
+// using OAuth client credentials grant.
+// The token is cached and refreshed DefaultTokenRefreshBefore its expiry.
+// See more: https://developer.ebay.com/api-docs/static/oauth-client-credentials-grant.html
+type ClientCredentialsProvider struct {
+	clientID      string
+	clientSecret  string
+	scopes        []string
+	endpoint      string
+	httpClient    *http.Client
+	refreshBefore time.Duration
+	now           func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+	// refresh is the token request in flight, nil if there is none
+	refresh *tokenRefresh
+}
+
+// tokenRefresh is a token request shared by concurrent callers of Token
+type tokenRefresh struct {
+	done   chan struct{}
+	token  string
+	expiry time.Time
+	err    error
+}
+
+// tokenRefreshTimeout limits a token request which is not bound to the context of any caller
+const tokenRefreshTimeout = time.Minute
+
+// NewClientCredentialsProvider creates new ClientCredentialsProvider for the application keys.
+// Default endpoint: EbayOAuthEndpointProduction
+// Default scope: EbayOAuthScopePublic
+func NewClientCredentialsProvider(clientID, clientSecret string, scopes ...string) *ClientCredentialsProvider {
+	if len(scopes) == 0 {
+		scopes = []string{EbayOAuthScopePublic}
+	}
+	return &ClientCredentialsProvider{
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		scopes:        scopes,
+		endpoint:      EbayOAuthEndpointProduction,
+		httpClient:    &http.Client{Timeout: 10 * time.Second},
+		refreshBefore: DefaultTokenRefreshBefore,
+		now:           time.Now,
+	}
+}
+
+// WithEndpoint changes OAuth endpoint (e.g. EbayOAuthEndpointSandbox)
+func (p *ClientCredentialsProvider) WithEndpoint(endpoint string) *ClientCredentialsProvider {
+	p.endpoint = endpoint
+	return p
+}
+
+// WithHTTPClient changes http.Client which is used to mint tokens
+func (p *ClientCredentialsProvider) WithHTTPClient(client *http.Client) *ClientCredentialsProvider {
+	if client != nil {
+		p.httpClient = client
+	}
+	return p
+}
+
+// WithRefreshBefore changes the time before token expiry when the token is refreshed
+func (p *ClientCredentialsProvider) WithRefreshBefore(d time.Duration) *ClientCredentialsProvider {
+	p.refreshBefore = d
+	return p
+}
+
+// Token implements TokenProvider. The cached token is returned until it is about to expire.
+// Concurrent callers share one token request. Every caller stops waiting for it when its ctx is done,
+// but the request goes on, so the token is cached for the next callers.
+func (p *ClientCredentialsProvider) Token(ctx context.Context) (string, time.Time, error) {
+	p.mu.Lock()
+	if p.token != "" && p.now().Add(p.refreshBefore).Before(p.expiry) {
+		token, expiry := p.token, p.expiry
+		p.mu.Unlock()
+		return token, expiry, nil
+	}
+	r := p.refresh
+	if r == nil {
+		r = &tokenRefresh{done: make(chan struct{})}
+		p.refresh = r
+		go p.runRefresh(r)
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return "", time.Time{}, fmt.Errorf("waiting for token: %w", ctx.Err())
+	case <-r.done:
+		return r.token, r.expiry, r.err
+	}
+}
+
+// runRefresh mints new token, caches it and passes it to the callers waiting for r
+func (p *ClientCredentialsProvider) runRefresh(r *tokenRefresh) {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenRefreshTimeout)
+	defer cancel()
+	r.token, r.expiry, r.err = p.mint(ctx)
+
+	p.mu.Lock()
+	if r.err == nil {
+		p.token, p.expiry = r.token, r.expiry
+	}
+	p.refresh = nil
+	p.mu.Unlock()
+	close(r.done)
+}
+
+// InvalidateToken implements TokenInvalidator. The next call of Token mints a new token.
+func (p *ClientCredentialsProvider) InvalidateToken(token string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token == token {
+		p.token = ""
+	}
+}
+
+// tokenResponse is a response of OAuth endpoint
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	TokenType        string `json:"token_type"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// mint requests new token from OAuth endpoint
+func (p *ClientCredentialsProvider) mint(ctx context.Context) (string, time.Time, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("scope", strings.Join(p.scopes, " "))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("creating token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(p.clientID, p.clientSecret)
+	requested := p.now()
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("requesting token: %w", err)
+	}
+	defer res.Body.Close()
+	tr := tokenResponse{}
+	if err = json.NewDecoder(res.Body).Decode(&tr); err != nil {
+		return "", time.Time{}, fmt.Errorf("parsing token response (status code %d): %w", res.StatusCode, err)
+	}
+	if res.StatusCode != http.StatusOK || tr.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("requesting token: status code %d: %s %s",
+			res.StatusCode, tr.Error, tr.ErrorDescription)
+	}
+	return tr.AccessToken, requested.Add(time.Duration(tr.ExpiresIn) * time.Second), nil
+}
@@ -0,0 +1,149 @@
+package shopping
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestOAuthServer creates OAuth endpoint which mints tokens "token-1", "token-2" etc.
+func newTestOAuthServer(t *testing.T, minted *int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "id", id)
+		assert.Equal(t, "secret", secret)
+		assert.Equal(t, "client_credentials", r.FormValue("grant_type"))
+		assert.Equal(t, EbayOAuthScopePublic, r.FormValue("scope"))
+		n := atomic.AddInt64(minted, 1)
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":7200,"token_type":"Application Access Token"}`, n)
+	}))
+}
+
+func TestClientCredentialsProvider_Token(t *testing.T) {
+	var minted int64
+	server := newTestOAuthServer(t, &minted)
+	defer server.Close()
+
+	now := time.Date(2021, 11, 27, 0, 0, 0, 0, time.UTC)
+	p := NewClientCredentialsProvider("id", "secret").WithEndpoint(server.URL)
+	p.now = func() time.Time { return now }
+
+	token, expiry, err := p.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+	assert.Equal(t, now.Add(2*time.Hour), expiry)
+
+	// cached
+	now = now.Add(time.Hour)
+	token, _, _ = p.Token(context.Background())
+	assert.Equal(t, "token-1", token)
+
+	// refreshed before expiry
+	now = now.Add(56 * time.Minute)
+	token, _, _ = p.Token(context.Background())
+	assert.Equal(t, "token-2", token)
+
+	p.InvalidateToken("token-1")
+	token, _, _ = p.Token(context.Background())
+	assert.Equal(t, "token-2", token)
+	p.InvalidateToken("token-2")
+	token, _, _ = p.Token(context.Background())
+	assert.Equal(t, "token-3", token)
+}
+
+func TestClientCredentialsProvider_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"client authentication failed"}`))
+	}))
+	defer server.Close()
+
+	p := NewClientCredentialsProvider("id", "secret").WithEndpoint(server.URL)
+	_, _, err := p.Token(context.Background())
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid_client")
+	}
+
+	_, err = NewService("").WithEndpoint(server.URL).WithTokenProvider(p).
+		NewGeteBayTimeRequest().ExecuteContext(context.Background())
+	assert.Error(t, err)
+}
+
+func TestService_WithTokenProvider(t *testing.T) {
+	var minted int64
+	oauth := newTestOAuthServer(t, &minted)
+	defer oauth.Close()
+
+	var calls int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&calls, 1)
+		if r.Header.Get("X-EBAY-API-IAF-TOKEN") == "token-1" {
+			_, _ = w.Write([]byte(`<GeteBayTimeResponse xmlns="urn:ebay:apis:eBLBaseComponents"><Ack>Failure</Ack>` +
+				`<Errors><ShortMessage>Expired IAF token.</ShortMessage><ErrorCode>1.32</ErrorCode>` +
+				`<SeverityCode>Error</SeverityCode><ErrorClassification>RequestError</ErrorClassification></Errors>` +
+				`</GeteBayTimeResponse>`))
+			return
+		}
+		assert.Equal(t, "token-2", r.Header.Get("X-EBAY-API-IAF-TOKEN"))
+		_, _ = w.Write([]byte(testTimeResponse))
+	}))
+	defer server.Close()
+
+	p := NewClientCredentialsProvider("id", "secret").WithEndpoint(oauth.URL)
+	service := NewService("").WithEndpoint(server.URL).WithTokenProvider(p)
+	res, err := service.NewGeteBayTimeRequest().ExecuteContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, AckSuccess, res.Ack)
+	assert.Equal(t, int64(2), atomic.LoadInt64(&calls))
+	assert.Equal(t, int64(2), atomic.LoadInt64(&minted))
+}
+
+func TestClientCredentialsProvider_SharedRefresh(t *testing.T) {
+	var minted int64
+	release := make(chan struct{})
+	requested := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- struct{}{}
+		<-release
+		n := atomic.AddInt64(&minted, 1)
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":7200}`, n)
+	}))
+	defer server.Close()
+	p := NewClientCredentialsProvider("id", "secret").WithEndpoint(server.URL)
+
+	// the first caller gives up, the token request goes on
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, _, err := p.Token(ctx)
+		first <- err
+	}()
+	<-requested
+	cancel()
+	assert.ErrorIs(t, <-first, context.Canceled)
+
+	// a waiter with short deadline does not wait for the token request
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := p.Token(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	second := make(chan string, 1)
+	go func() {
+		token, _, _ := p.Token(context.Background())
+		second <- token
+	}()
+	close(release)
+	assert.Equal(t, "token-1", <-second)
+	token, _, err := p.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&minted))
+}
@@ -0,0 +1,119 @@
+package shopping
+
+import (
+	"context"
+	"errors"
+)
+
+// Tracer starts spans of calls to eBay (see Service.WithTracer).
+// The span started by Start must be a child of the span in ctx (if any).
+// See package oteltracing for OpenTelemetry.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by Tracer
+type Span interface {
+	SetAttributes(attributes ...SpanAttribute)
+	// RecordError marks the span as failed
+	RecordError(err error)
+	End()
+}
+
+// SpanAttribute is an attribute of Span. Value is string, int, bool or []string.
+type SpanAttribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Span attribute keys
+const (
+	SpanAttributeOperation     = "ebay.operation"
+	SpanAttributeSiteID        = "ebay.site_id"
+	SpanAttributeItemCount     = "ebay.item_count"
+	SpanAttributePageNumber    = "ebay.page_number"
+	SpanAttributeCorrelationID = "ebay.correlation_id"
+	SpanAttributeAck           = "ebay.ack"
+	SpanAttributeErrorCodes    = "ebay.error_codes"
+	SpanAttributeAttempts      = "ebay.attempts"
+	SpanAttributeCacheHit      = "ebay.cache_hit"
+)
+
+// callSpanKey is a context key of *callSpan
+type callSpanKey struct{}
+
+// callSpan is a span of Execute or GetPage call. Nil callSpan does nothing.
+type callSpan struct {
+	span     Span
+	attempts int
+}
+
+// startSpan starts span of the request if the service has a tracer.
+// The span is named "ebay.shopping.<operation>".
+func (r *RequestBasic) startSpan(ctx context.Context, attributes ...SpanAttribute) (context.Context, *callSpan) {
+	tracer := r.service.tracer
+	if tracer == nil {
+		return ctx, nil
+	}
+	ctx, span := tracer.Start(ctx, "ebay.shopping."+string(r.operation))
+	span.SetAttributes(append([]SpanAttribute{
+		{Key: SpanAttributeOperation, Value: string(r.operation)},
+		{Key: SpanAttributeSiteID, Value: r.siteID},
+	}, attributes...)...)
+	cs := &callSpan{span: span}
+	return context.WithValue(ctx, callSpanKey{}, cs), cs
+}
+
+// spanFromContext returns span started by startSpan
+func spanFromContext(ctx context.Context) *callSpan {
+	cs, _ := ctx.Value(callSpanKey{}).(*callSpan)
+	return cs
+}
+
+// setAttempts records the number of attempts made by the call
+func (s *callSpan) setAttempts(attempts int) {
+	if s != nil {
+		s.attempts = attempts
+	}
+}
+
+// setCacheHit records that the response was taken from the service cache
+func (s *callSpan) setCacheHit() {
+	if s != nil {
+		s.span.SetAttributes(SpanAttribute{Key: SpanAttributeCacheHit, Value: true})
+	}
+}
+
+// end records the response and the error and ends the span
+func (s *callSpan) end(ar standardResponse, err error) {
+	if s == nil {
+		return
+	}
+	rs := ar.standard()
+	var attributes []SpanAttribute
+	if rs.Ack != "" {
+		attributes = append(attributes, SpanAttribute{Key: SpanAttributeAck, Value: rs.Ack})
+	}
+	if rs.CorrelationID != "" {
+		attributes = append(attributes, SpanAttribute{Key: SpanAttributeCorrelationID, Value: rs.CorrelationID})
+	}
+	codes := make([]string, 0, len(rs.Errors))
+	for _, e := range rs.Errors {
+		codes = append(codes, e.ErrorCode)
+	}
+	var apiErr *APIError
+	if len(codes) == 0 && errors.As(err, &apiErr) {
+		codes = apiErr.ErrorCodes()
+	}
+	if len(codes) > 0 {
+		attributes = append(attributes, SpanAttribute{Key: SpanAttributeErrorCodes, Value: codes})
+	}
+	if s.attempts > 0 {
+		attributes = append(attributes, SpanAttribute{Key: SpanAttributeAttempts, Value: s.attempts})
+	}
+	s.span.SetAttributes(attributes...)
+	if err != nil {
+		s.span.RecordError(err)
+	}
+	s.span.End()
+}
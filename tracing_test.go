@@ -0,0 +1,126 @@
+package shopping
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testTracer records spans
+type testTracer struct {
+	mu    sync.Mutex
+	spans []*testSpan
+}
+
+type testSpan struct {
+	name       string
+	parent     *testSpan
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+type testSpanKey struct{}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	parent, _ := ctx.Value(testSpanKey{}).(*testSpan)
+	span := &testSpan{name: name, parent: parent, attributes: make(map[string]interface{})}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, testSpanKey{}, span), span
+}
+
+func (s *testSpan) SetAttributes(attributes ...SpanAttribute) {
+	for _, a := range attributes {
+		s.attributes[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+func TestService_WithTracer(t *testing.T) {
+	var calls int
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return newStaticTransport(http.StatusServiceUnavailable, "", nil).RoundTrip(r)
+		}
+		return newStaticTransport(http.StatusOK, `<GetItemStatusResponse xmlns="urn:ebay:apis:eBLBaseComponents">`+
+			`<Ack>PartialFailure</Ack><CorrelationID>c1</CorrelationID>`+
+			`<Errors><ErrorCode>10.12</ErrorCode><SeverityCode>Error</SeverityCode></Errors>`+
+			`</GetItemStatusResponse>`, nil).RoundTrip(r)
+	})
+	tracer := &testTracer{}
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	service := NewService("").WithTransport(transport).WithRetryPolicy(policy).WithTracer(tracer)
+
+	parent := &testSpan{name: "parent"}
+	ctx := context.WithValue(context.Background(), testSpanKey{}, parent)
+	_, err := service.NewGetItemStatusRequest().WithItemID("1", "2").ExecuteContext(ctx)
+	assert.Error(t, err)
+
+	if !assert.Len(t, tracer.spans, 1) {
+		return
+	}
+	span := tracer.spans[0]
+	assert.Equal(t, "ebay.shopping.GetItemStatus", span.name)
+	assert.Same(t, parent, span.parent)
+	assert.True(t, span.ended)
+	assert.Equal(t, err, span.err)
+	assert.Equal(t, map[string]interface{}{
+		SpanAttributeOperation:     string(OperationGetItemStatus),
+		SpanAttributeSiteID:        string(SiteIDEbayUS),
+		SpanAttributeItemCount:     2,
+		SpanAttributeAck:           AckPartialFailure,
+		SpanAttributeCorrelationID: "c1",
+		SpanAttributeErrorCodes:    []string{"10.12"},
+		SpanAttributeAttempts:      2,
+	}, span.attributes)
+}
+
+func TestService_WithTracerFindProductsPage(t *testing.T) {
+	tracer := &testTracer{}
+	service := NewService("").
+		WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, nil)).
+		WithTracer(tracer)
+	_, err := service.NewFindProductsRequest().WithQueryKeywords("Harry Potter").GetPageContext(context.Background(), 3)
+	assert.NoError(t, err)
+	if assert.Len(t, tracer.spans, 1) {
+		assert.Equal(t, 3, tracer.spans[0].attributes[SpanAttributePageNumber])
+		assert.Nil(t, tracer.spans[0].err)
+	}
+
+	// validation errors are recorded too
+	_, err = service.NewGetSingleItemRequest().ExecuteContext(context.Background())
+	assert.Error(t, err)
+	if assert.Len(t, tracer.spans, 2) {
+		assert.Equal(t, err, tracer.spans[1].err)
+		assert.Nil(t, tracer.spans[1].attributes[SpanAttributeAttempts])
+	}
+}
+
+func TestService_WithTracerDeduplication(t *testing.T) {
+	tracer := &testTracer{}
+	service := NewService("").
+		WithTransport(newStaticTransport(http.StatusOK, testTimeResponse, nil)).
+		WithRequestDeduplication(true).
+		WithTracer(tracer)
+
+	_, err := service.NewGeteBayTimeRequest().ExecuteContext(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, tracer.spans, 1) {
+		assert.Equal(t, 1, tracer.spans[0].attributes[SpanAttributeAttempts])
+	}
+}
@@ -1,6 +1,7 @@
 package shopping
 
 import (
+	"fmt"
 	"time"
 	"unicode"
 )
@@ -19,6 +20,20 @@ func ToEbayDateTime(datetime time.Time) string {
 	return datetime.Format("2006-01-02T15:04:05.000Z")
 }
 
+// ToEbayDuration converts Golang duration to eBay format PnDTnHnMnS (e.g., P2DT23H32M51S)
+func ToEbayDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+	return fmt.Sprintf("P%dDT%dH%dM%dS", days, hours, minutes, d/time.Second)
+}
+
 // FromEbayDuration converts eBay duration to Golang duration
 // eBay format is PnYnMnDTnHnMnS (e.g., P2DT23H32M51S)
 func FromEbayDuration(ebayDuration string) time.Duration {
@@ -0,0 +1,98 @@
+package shopping
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Limits of request fields checked by Validate
+const (
+	// MaxQueryKeywordsLength is the maximum length of FindProductsRequest.QueryKeywords
+	MaxQueryKeywordsLength = 350
+	// MinQueryKeywordsAlphanumerics is the minimum number of alphanumeric characters in FindProductsRequest.QueryKeywords
+	MinQueryKeywordsAlphanumerics = 3
+)
+
+// FieldError describes an invalid field of a request
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error implements error interface
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError is returned by Validate and Execute methods when the request is invalid.
+// The request is not sent to eBay in this case.
+type ValidationError struct {
+	Operation EbayOperation
+	Fields    []FieldError
+}
+
+// Error implements error interface
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Error())
+	}
+	return fmt.Sprintf("%s: invalid request: %s", e.Operation, strings.Join(msgs, "; "))
+}
+
+// HasField checks if the field is invalid
+func (e *ValidationError) HasField(field string) bool {
+	for _, f := range e.Fields {
+		if f.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// validator collects field errors of a request
+type validator struct {
+	fields []FieldError
+}
+
+// add adds field error
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.fields = append(v.fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// required adds field error if value is empty
+func (v *validator) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.add(field, "is required")
+	}
+}
+
+// itemIDs checks the number of item IDs
+func (v *validator) itemIDs(field string, ids []string) {
+	switch {
+	case len(ids) == 0:
+		v.add(field, "is required")
+	case len(ids) > MaxItemIDsPerCall:
+		v.add(field, "has %d values, max %d", len(ids), MaxItemIDsPerCall)
+	}
+}
+
+// err returns *ValidationError or nil if there are no field errors
+func (v *validator) err(operation EbayOperation) error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Operation: operation, Fields: v.fields}
+}
+
+// countAlphanumerics returns the number of letters and digits in s
+func countAlphanumerics(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			n++
+		}
+	}
+	return n
+}
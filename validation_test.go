@@ -0,0 +1,122 @@
+package shopping
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	s := NewService("")
+	var ids []string
+	for i := 0; i < MaxItemIDsPerCall+1; i++ {
+		ids = append(ids, string(rune('a'+i)))
+	}
+	tests := []struct {
+		name       string
+		validate   func() error
+		wantFields []string
+	}{
+		{
+			name:     "FindProducts keywords",
+			validate: s.NewFindProductsRequest().WithQueryKeywords("Harry Potter").Validate,
+		},
+		{
+			name:     "FindProducts category",
+			validate: s.NewFindProductsRequest().WithCategoryID("267").Validate,
+		},
+		{
+			name:       "FindProducts empty",
+			validate:   s.NewFindProductsRequest().Validate,
+			wantFields: []string{"QueryKeywords"},
+		},
+		{
+			name:       "FindProducts short keywords",
+			validate:   s.NewFindProductsRequest().WithQueryKeywords("a -b").Validate,
+			wantFields: []string{"QueryKeywords"},
+		},
+		{
+			name:       "FindProducts long keywords",
+			validate:   s.NewFindProductsRequest().WithQueryKeywords(strings.Repeat("a", MaxQueryKeywordsLength+1)).Validate,
+			wantFields: []string{"QueryKeywords"},
+		},
+		{
+			name:       "GetCategoryInfo",
+			validate:   s.NewGetCategoryInfoRequest().Validate,
+			wantFields: []string{"CategoryID"},
+		},
+		{
+			name:     "GeteBayTime",
+			validate: s.NewGeteBayTimeRequest().Validate,
+		},
+		{
+			name:       "GetItemStatus empty",
+			validate:   s.NewGetItemStatusRequest().Validate,
+			wantFields: []string{"ItemID"},
+		},
+		{
+			name:       "GetMultipleItems too many",
+			validate:   s.NewGetMultipleItemsRequest().WithItemIDs(ids...).Validate,
+			wantFields: []string{"ItemID"},
+		},
+		{
+			name:     "GetMultipleItems",
+			validate: s.NewGetMultipleItemsRequest().WithItemIDs(ids[:MaxItemIDsPerCall]...).Validate,
+		},
+		{
+			name:       "GetShippingCosts",
+			validate:   s.NewGetShippingCostsRequest().WithDestinationCountryCode(CountryCodeUS).Validate,
+			wantFields: []string{"ItemID", "DestinationPostalCode"},
+		},
+		{
+			name:       "GetSingleItem",
+			validate:   s.NewGetSingleItemRequest().Validate,
+			wantFields: []string{"ItemID"},
+		},
+		{
+			name:       "GetUserProfile",
+			validate:   s.NewGetUserProfileRequest().Validate,
+			wantFields: []string{"UserID"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validate()
+			if len(tt.wantFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *ValidationError
+			if !assert.True(t, errors.As(err, &validationErr), "got %v", err) {
+				return
+			}
+			var fields []string
+			for _, f := range validationErr.Fields {
+				fields = append(fields, f.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestRequest_ExecuteValidates(t *testing.T) {
+	var calls int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&calls, 1)
+	}))
+	defer server.Close()
+	s := NewService("").WithEndpoint(server.URL)
+
+	_, err := s.NewGetSingleItemRequest().Execute()
+	assert.Error(t, err)
+	_, err = s.NewGetUserProfileRequest().Execute()
+	assert.Error(t, err)
+	_, err = s.NewFindProductsRequest().GetPage(2)
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), atomic.LoadInt64(&calls))
+}